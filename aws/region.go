@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// RegionResolver resolves the regions where resources are deployed from the "provider" blocks in the Terraform configuration
+type RegionResolver struct {
+	credentials map[string]Credentials
+}
+
+// NewRegionResolver returns a resolver for the provider configurations of the root module.
+// In child modules, the provider configurations are passed from the calling module,
+// so the resolver does not determine any regions.
+func NewRegionResolver(runner tflint.Runner) (*RegionResolver, error) {
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return nil, err
+	}
+	if !path.IsRoot() {
+		return &RegionResolver{credentials: map[string]Credentials{}}, nil
+	}
+
+	credentials, err := GetCredentialsFromProvider(runner)
+	if err != nil {
+		return nil, err
+	}
+	return &RegionResolver{credentials: credentials}, nil
+}
+
+// ProviderRegion returns the region of the provider configuration.
+// The alias is "aws" for the default provider configuration.
+// It returns an empty string if the region is not declared or cannot be evaluated.
+func (r *RegionResolver) ProviderRegion(alias string) string {
+	return r.credentials[alias].Region
+}
+
+// ResourceRegion returns the region of the provider configuration that the resource uses.
+// The "provider" meta-argument must be included in the schema of the resource.
+// It returns an empty string if the region cannot be determined.
+func (r *RegionResolver) ResourceRegion(resource *hclext.Block) string {
+	alias := "aws"
+	if attribute, exists := resource.Body.Attributes["provider"]; exists {
+		ref, diags := DecodeProviderConfigRef(attribute.Expr, "provider")
+		if diags.HasErrors() {
+			logger.Error("parse resource provider attribute: %s", diags)
+			return ""
+		}
+		if ref.Alias != "" {
+			alias = ref.Alias
+		}
+	}
+
+	return r.ProviderRegion(alias)
+}
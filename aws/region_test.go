@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/addrs"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type childModuleRunner struct {
+	*helper.Runner
+}
+
+func (r *childModuleRunner) GetModulePath() (addrs.Module, error) {
+	return addrs.Module{"child"}, nil
+}
+
+func Test_RegionResolver(t *testing.T) {
+	config := `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+
+resource "aws_instance" "default" {
+}
+
+resource "aws_instance" "west" {
+  provider = aws.west
+}
+
+resource "aws_instance" "invalid" {
+  provider = aws.west.invalid
+}
+`
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "provider"}},
+	}
+
+	cases := []struct {
+		Name     string
+		Child    bool
+		Expected map[string]string
+	}{
+		{
+			Name: "root module",
+			Expected: map[string]string{
+				"default": "us-east-1",
+				"west":    "us-west-2",
+				"invalid": "",
+			},
+		},
+		{
+			Name:  "child module",
+			Child: true,
+			Expected: map[string]string{
+				"default": "",
+				"west":    "",
+				"invalid": "",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var runner tflint.Runner = helper.TestRunner(t, map[string]string{"main.tf": config})
+			if tc.Child {
+				runner = &childModuleRunner{Runner: runner.(*helper.Runner)}
+			}
+
+			regions, err := NewRegionResolver(runner)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resources, err := runner.GetResourceContent("aws_instance", schema, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := map[string]string{}
+			for _, resource := range resources.Blocks {
+				got[resource.Labels[1]] = regions.ResourceRegion(resource)
+			}
+			if diff := cmp.Diff(tc.Expected, got); diff != "" {
+				t.Fatalf("Failed: diff: %s", diff)
+			}
+		})
+	}
+}
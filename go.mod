@@ -3,7 +3,7 @@ module github.com/terraform-linters/tflint-ruleset-aws
 go 1.20
 
 require (
-	github.com/agext/levenshtein v1.2.2 // indirect
+	github.com/agext/levenshtein v1.2.2
 	github.com/aws/aws-sdk-go v1.44.299
 	github.com/dave/dst v0.27.2
 	github.com/fatih/color v1.13.0 // indirect
@@ -32,6 +32,7 @@ require (
 	github.com/stretchr/testify v1.8.4
 	golang.org/x/exp v0.0.0-20230425010034-47ecfdc1ba53
 	golang.org/x/net v0.12.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -53,5 +54,4 @@ require (
 	golang.org/x/sys v0.10.0 // indirect
 	golang.org/x/text v0.11.0 // indirect
 	golang.org/x/tools v0.10.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -8,6 +8,9 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
+// apiGatewayModelNamePattern is the pattern of model names in API Gateway REST APIs
+var apiGatewayModelNamePattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
 // AwsAPIGatewayModelInvalidNameRule checks the name is alphanumeric
 type AwsAPIGatewayModelInvalidNameRule struct {
 	tflint.DefaultRule
@@ -22,7 +25,7 @@ func NewAwsAPIGatewayModelInvalidNameRule() *AwsAPIGatewayModelInvalidNameRule {
 	return &AwsAPIGatewayModelInvalidNameRule{
 		resourceType:  "aws_api_gateway_model",
 		attributeName: "name",
-		pattern:       regexp.MustCompile("^[a-zA-Z0-9]+$"),
+		pattern:       apiGatewayModelNamePattern,
 	}
 }
 
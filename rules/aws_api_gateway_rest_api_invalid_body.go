@@ -0,0 +1,397 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+)
+
+// AwsAPIGatewayRestAPIInvalidBodyRule checks whether OpenAPI definitions in `body` can be imported to API Gateway
+type AwsAPIGatewayRestAPIInvalidBodyRule struct {
+	tflint.DefaultRule
+
+	resourceTypes []string
+	attributeName string
+	// operations are keys of path items that declare operations, and their HTTP methods
+	operations []openAPIOperation
+}
+
+type openAPIOperation struct {
+	key    string
+	method string
+}
+
+// apiGatewayModelReferencePattern matches references to models of REST APIs, such as
+// "https://apigateway.amazonaws.com/restapis/abc123/models/Pet". The model name is captured.
+var apiGatewayModelReferencePattern = regexp.MustCompile(`^https://apigateway\.amazonaws\.com/restapis/[^/]+/models/([^/]+)$`)
+
+// openAPIModelReference is a reference to a model that is not declared in the body of the REST API
+type openAPIModelReference struct {
+	ref   string
+	model string
+	rng   hcl.Range
+}
+
+// NewAwsAPIGatewayRestAPIInvalidBodyRule returns new rule with default attributes
+func NewAwsAPIGatewayRestAPIInvalidBodyRule() *AwsAPIGatewayRestAPIInvalidBodyRule {
+	return &AwsAPIGatewayRestAPIInvalidBodyRule{
+		resourceTypes: []string{"aws_api_gateway_rest_api", "aws_apigatewayv2_api"},
+		attributeName: "body",
+		operations: []openAPIOperation{
+			{key: "get", method: "GET"},
+			{key: "put", method: "PUT"},
+			{key: "post", method: "POST"},
+			{key: "delete", method: "DELETE"},
+			{key: "options", method: "OPTIONS"},
+			{key: "head", method: "HEAD"},
+			{key: "patch", method: "PATCH"},
+			{key: "x-amazon-apigateway-any-method", method: "ANY"},
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayRestAPIInvalidBodyRule) Name() string {
+	return "aws_api_gateway_rest_api_invalid_body"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayRestAPIInvalidBodyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayRestAPIInvalidBodyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayRestAPIInvalidBodyRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks the OpenAPI definitions of REST APIs and HTTP APIs.
+// Models declared in the body of a REST API must not be declared again by aws_api_gateway_model,
+// and models referred to by the body must be declared in the body or by aws_api_gateway_model.
+func (r *AwsAPIGatewayRestAPIInvalidBodyRule) Check(runner tflint.Runner) error {
+	// schemas maps REST API addresses to the model names declared in the body
+	schemas := map[string][]string{}
+	// modelRefs maps REST API addresses to references to models that are not declared in the body
+	modelRefs := map[string][]openAPIModelReference{}
+
+	for _, resourceType := range r.resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		restAPI := resourceType == "aws_api_gateway_rest_api"
+
+		for _, resource := range resources.Blocks {
+			attribute, exists := resource.Body.Attributes[r.attributeName]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(body string) error {
+				document, err := parseOpenAPIDocument(body)
+				if err != nil {
+					return runner.EmitIssue(r, fmt.Sprintf("body is not a valid JSON or YAML document: %s", err), attribute.Expr.Range())
+				}
+
+				messages := r.validate(document, restAPI)
+				if restAPI {
+					address := fmt.Sprintf("%s.%s", resourceType, resource.Labels[1])
+					names := openAPISchemaNames(document)
+					schemas[address] = names
+
+					for _, name := range names {
+						if !apiGatewayModelNamePattern.MatchString(name) {
+							messages = append(messages, fmt.Sprintf(`The schema "%s" is not a valid model name. It must match %s`, name, apiGatewayModelNamePattern))
+						}
+					}
+
+					for _, ref := range collectOpenAPIReferences(document) {
+						match := apiGatewayModelReferencePattern.FindStringSubmatch(ref)
+						if match == nil || stringInSlice(match[1], names) {
+							continue
+						}
+						modelRefs[address] = append(modelRefs[address], openAPIModelReference{ref: ref, model: match[1], rng: attribute.Expr.Range()})
+					}
+				}
+
+				for _, message := range messages {
+					if err := runner.EmitIssue(r, message, attribute.Expr.Range()); err != nil {
+						return err
+					}
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return r.checkModels(runner, schemas, modelRefs)
+}
+
+// validate returns messages for each error in the document.
+// Operations of REST APIs must have integrations, while routes of HTTP APIs can be declared without them.
+func (r *AwsAPIGatewayRestAPIInvalidBodyRule) validate(document map[string]interface{}, requireIntegrations bool) []string {
+	messages := []string{}
+
+	openapi, isOpenAPI := document["openapi"].(string)
+	swagger, isSwagger := document["swagger"]
+	if !(isOpenAPI && strings.HasPrefix(openapi, "3.")) && !(isSwagger && (fmt.Sprint(swagger) == "2.0" || fmt.Sprint(swagger) == "2")) {
+		messages = append(messages, "body must declare `openapi` 3.x or `swagger` 2.0")
+	}
+
+	paths, ok := document["paths"].(map[string]interface{})
+	if !ok {
+		messages = append(messages, "body must declare `paths` as an object")
+	}
+
+	if requireIntegrations {
+		keys := maps.Keys(paths)
+		sort.Strings(keys)
+
+		for _, path := range keys {
+			item, ok := paths[path].(map[string]interface{})
+			// Path items that refer to other objects are checked as references
+			if !ok || item["$ref"] != nil {
+				continue
+			}
+
+			for _, operation := range r.operations {
+				declaration, ok := item[operation.key].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if _, exists := declaration["x-amazon-apigateway-integration"]; !exists {
+					messages = append(messages, fmt.Sprintf(`The operation "%s %s" has no x-amazon-apigateway-integration`, operation.method, path))
+				}
+			}
+		}
+	}
+
+	for _, ref := range collectOpenAPIReferences(document) {
+		// References to models of REST APIs are resolved with aws_api_gateway_model
+		if requireIntegrations && apiGatewayModelReferencePattern.MatchString(ref) {
+			continue
+		}
+		if _, ok := resolveOpenAPIReference(document, ref); !ok {
+			messages = append(messages, fmt.Sprintf(`The reference "%s" cannot be resolved`, ref))
+		}
+	}
+
+	return messages
+}
+
+// checkModels emits an issue if aws_api_gateway_model declares a model that is also declared in the body of the REST API,
+// or if the body refers to a model that is declared neither in the body nor by aws_api_gateway_model
+func (r *AwsAPIGatewayRestAPIInvalidBodyRule) checkModels(runner tflint.Runner, schemas map[string][]string, modelRefs map[string][]openAPIModelReference) error {
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	resources, err := runner.GetResourceContent("aws_api_gateway_model", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "rest_api_id"}, {Name: "name"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// models maps REST API addresses to the model names declared by aws_api_gateway_model
+	models := map[string][]string{}
+	// If a model cannot be associated with a REST API, any model may be declared
+	unknown := false
+
+	for _, resource := range resources.Blocks {
+		restAPIAttr, exists := resource.Body.Attributes["rest_api_id"]
+		if !exists {
+			continue
+		}
+		nameAttr, exists := resource.Body.Attributes["name"]
+		if !exists {
+			continue
+		}
+
+		restAPI, ok := resourceReference(restAPIAttr.Expr, "aws_api_gateway_rest_api", "id")
+		if !ok {
+			unknown = true
+			continue
+		}
+
+		known := false
+		err := runner.EvaluateExpr(nameAttr.Expr, func(name string) error {
+			known = true
+			models[restAPI] = append(models[restAPI], name)
+
+			if !stringInSlice(name, schemas[restAPI]) {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf(`The model "%s" is already declared in the body of %s`, name, restAPI),
+				nameAttr.Expr.Range(),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+		if !known {
+			unknown = true
+		}
+	}
+
+	if unknown {
+		return nil
+	}
+
+	restAPIs := maps.Keys(modelRefs)
+	sort.Strings(restAPIs)
+	for _, restAPI := range restAPIs {
+		for _, ref := range modelRefs[restAPI] {
+			if stringInSlice(ref.model, models[restAPI]) {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf(`The reference "%s" refers to the model "%s", which is declared neither in the body nor by aws_api_gateway_model`, ref.ref, ref.model),
+				ref.rng,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseOpenAPIDocument parses a JSON or YAML document. Non-string keys such as status codes are converted to strings.
+func parseOpenAPIDocument(src string) (map[string]interface{}, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal([]byte(src), &raw); err != nil {
+		return nil, err
+	}
+
+	document, ok := normalizeOpenAPIValue(raw).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the document must be an object")
+	}
+	return document, nil
+}
+
+func normalizeOpenAPIValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, element := range value {
+			value[key] = normalizeOpenAPIValue(element)
+		}
+		return value
+	case map[interface{}]interface{}:
+		normalized := make(map[string]interface{}, len(value))
+		for key, element := range value {
+			normalized[fmt.Sprint(key)] = normalizeOpenAPIValue(element)
+		}
+		return normalized
+	case []interface{}:
+		for i, element := range value {
+			value[i] = normalizeOpenAPIValue(element)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+// openAPISchemaNames returns the names of schemas that API Gateway imports as models
+func openAPISchemaNames(document map[string]interface{}) []string {
+	schemas, ok := document["definitions"].(map[string]interface{})
+	if components, isOpenAPI := document["components"].(map[string]interface{}); isOpenAPI {
+		schemas, ok = components["schemas"].(map[string]interface{})
+	}
+	if !ok {
+		return []string{}
+	}
+
+	names := maps.Keys(schemas)
+	sort.Strings(names)
+	return names
+}
+
+// collectOpenAPIReferences returns the unique "$ref" values in the document, sorted
+func collectOpenAPIReferences(document map[string]interface{}) []string {
+	refs := map[string]bool{}
+	walkOpenAPIReferences(document, refs)
+
+	keys := maps.Keys(refs)
+	sort.Strings(keys)
+	return keys
+}
+
+func walkOpenAPIReferences(value interface{}, refs map[string]bool) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := value["$ref"].(string); ok {
+			refs[ref] = true
+		}
+		for _, element := range value {
+			walkOpenAPIReferences(element, refs)
+		}
+	case []interface{}:
+		for _, element := range value {
+			walkOpenAPIReferences(element, refs)
+		}
+	}
+}
+
+// resolveOpenAPIReference resolves a JSON pointer such as "#/components/schemas/Pet".
+// References to other documents cannot be resolved, as API Gateway does not import them.
+func resolveOpenAPIReference(document map[string]interface{}, ref string) (interface{}, bool) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, false
+	}
+	pointer := strings.TrimPrefix(ref, "#")
+	if pointer == "" {
+		return document, true
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, false
+	}
+
+	var current interface{} = document
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch value := current.(type) {
+		case map[string]interface{}:
+			element, exists := value[token]
+			if !exists {
+				return nil, false
+			}
+			current = element
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(value) {
+				return nil, false
+			}
+			current = value[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
@@ -0,0 +1,260 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayRestAPIInvalidBody(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing integrations and broken references",
+			Content: `
+resource "aws_api_gateway_rest_api" "api" {
+  name = "api"
+  body = <<-EOF
+  openapi: 3.0.1
+  info:
+    title: api
+  paths:
+    /pets:
+      get:
+        responses:
+          200:
+            content:
+              application/json:
+                schema:
+                  $ref: "#/components/schemas/Pets"
+      post:
+        x-amazon-apigateway-integration:
+          type: mock
+  components:
+    schemas:
+      Pet:
+        type: object
+      pet_list:
+        type: array
+        items:
+          $ref: "#/components/schemas/Pet"
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIInvalidBodyRule(),
+					Message: `The operation "GET /pets" has no x-amazon-apigateway-integration`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 28, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsAPIGatewayRestAPIInvalidBodyRule(),
+					Message: `The reference "#/components/schemas/Pets" cannot be resolved`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 28, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsAPIGatewayRestAPIInvalidBodyRule(),
+					Message: `The schema "pet_list" is not a valid model name. It must match ^[a-zA-Z0-9]+$`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 28, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid OpenAPI",
+			Content: `
+resource "aws_api_gateway_rest_api" "api" {
+  name = "api"
+  body = "{\"openapi\": \"2.0\"}"
+}
+
+resource "aws_apigatewayv2_api" "api" {
+  name          = "api"
+  protocol_type = "HTTP"
+  body          = "[openapi"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIInvalidBodyRule(),
+					Message: "body must declare `openapi` 3.x or `swagger` 2.0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+				{
+					Rule:    NewAwsAPIGatewayRestAPIInvalidBodyRule(),
+					Message: "body must declare `paths` as an object",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+				{
+					Rule:    NewAwsAPIGatewayRestAPIInvalidBodyRule(),
+					Message: "body is not a valid JSON or YAML document: yaml: line 1: did not find expected ',' or ']'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 19},
+						End:      hcl.Pos{Line: 10, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "models declared twice",
+			Content: `
+resource "aws_api_gateway_rest_api" "api" {
+  name = "api"
+  body = <<-EOF
+  {
+    "swagger": "2.0",
+    "paths": {},
+    "definitions": {"Pet": {"type": "object"}}
+  }
+  EOF
+}
+
+resource "aws_api_gateway_model" "pet" {
+  rest_api_id  = aws_api_gateway_rest_api.api.id
+  name         = "Pet"
+  content_type = "application/json"
+  schema       = "{}"
+}
+
+resource "aws_api_gateway_model" "owner" {
+  rest_api_id  = aws_api_gateway_rest_api.api.id
+  name         = "Owner"
+  content_type = "application/json"
+  schema       = "{}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIInvalidBodyRule(),
+					Message: `The model "Pet" is already declared in the body of aws_api_gateway_rest_api.api`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 18},
+						End:      hcl.Pos{Line: 15, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "references to models",
+			Content: `
+resource "aws_api_gateway_rest_api" "api" {
+  name = "api"
+  body = <<-EOF
+  {
+    "swagger": "2.0",
+    "paths": {},
+    "definitions": {
+      "Pet": {"type": "object"},
+      "Pets": {"type": "array", "items": {"$ref": "https://apigateway.amazonaws.com/restapis/abc123/models/Pet"}},
+      "Owner": {"$ref": "https://apigateway.amazonaws.com/restapis/abc123/models/Person"},
+      "Shop": {"$ref": "https://apigateway.amazonaws.com/restapis/abc123/models/Store"},
+      "Food": {"$ref": "food.json#/Food"}
+    }
+  }
+  EOF
+}
+
+resource "aws_api_gateway_model" "person" {
+  rest_api_id  = aws_api_gateway_rest_api.api.id
+  name         = "Person"
+  content_type = "application/json"
+  schema       = "{}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayRestAPIInvalidBodyRule(),
+					Message: `The reference "food.json#/Food" cannot be resolved`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 16, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsAPIGatewayRestAPIInvalidBodyRule(),
+					Message: `The reference "https://apigateway.amazonaws.com/restapis/abc123/models/Store" refers to the model "Store", which is declared neither in the body nor by aws_api_gateway_model`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 16, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_api_gateway_rest_api" "api" {
+  name = "api"
+  body = <<-EOF
+  {
+    "openapi": "3.0.1",
+    "paths": {
+      "/pets/{id}": {
+        "get": {
+          "responses": {"200": {"$ref": "#/components/responses/Pet"}},
+          "x-amazon-apigateway-integration": {"type": "mock"}
+        }
+      },
+      "/owners": {"$ref": "#/paths/~1pets~1{id}"}
+    },
+    "components": {
+      "responses": {"Pet": {"description": "pet"}}
+    }
+  }
+  EOF
+}
+
+resource "aws_apigatewayv2_api" "api" {
+  name          = "api"
+  protocol_type = "HTTP"
+  body          = <<-EOF
+  openapi: 3.0.1
+  paths:
+    /pets:
+      get: {}
+  EOF
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayRestAPIInvalidBodyRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
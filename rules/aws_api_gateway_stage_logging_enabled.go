@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsAPIGatewayStageLoggingEnabledRule checks whether API Gateway REST API stages enable access logging
+type AwsAPIGatewayStageLoggingEnabledRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+	blockName    string
+}
+
+// NewAwsAPIGatewayStageLoggingEnabledRule returns new rule with default attributes
+func NewAwsAPIGatewayStageLoggingEnabledRule() *AwsAPIGatewayStageLoggingEnabledRule {
+	return &AwsAPIGatewayStageLoggingEnabledRule{
+		resourceType: "aws_api_gateway_stage",
+		blockName:    "access_log_settings",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsAPIGatewayStageLoggingEnabledRule) Name() string {
+	return "aws_api_gateway_stage_logging_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAPIGatewayStageLoggingEnabledRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsAPIGatewayStageLoggingEnabledRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsAPIGatewayStageLoggingEnabledRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether the access_log_settings block is declared
+func (r *AwsAPIGatewayStageLoggingEnabledRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: r.blockName, Body: &hclext.BodySchema{}}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if err := checkLoggingBlock(runner, r, resource, r.blockName, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
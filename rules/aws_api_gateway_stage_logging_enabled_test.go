@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsAPIGatewayStageLoggingEnabled(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "access logging is not configured",
+			Content: `
+resource "aws_api_gateway_stage" "stage" {
+  stage_name = "prod"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsAPIGatewayStageLoggingEnabledRule(),
+					Message: "`access_log_settings` block should be declared to enable logging",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "access logging is configured",
+			Content: `
+resource "aws_api_gateway_stage" "stage" {
+  stage_name = "prod"
+
+  access_log_settings {
+    destination_arn = "arn:aws:logs:us-east-1:111111111111:log-group:api"
+    format          = "$context.requestId"
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsAPIGatewayStageLoggingEnabledRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
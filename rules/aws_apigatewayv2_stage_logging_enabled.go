@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsApigatewayv2StageLoggingEnabledRule checks whether API Gateway V2 stages enable access logging
+type AwsApigatewayv2StageLoggingEnabledRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+	blockName    string
+}
+
+// NewAwsApigatewayv2StageLoggingEnabledRule returns new rule with default attributes
+func NewAwsApigatewayv2StageLoggingEnabledRule() *AwsApigatewayv2StageLoggingEnabledRule {
+	return &AwsApigatewayv2StageLoggingEnabledRule{
+		resourceType: "aws_apigatewayv2_stage",
+		blockName:    "access_log_settings",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsApigatewayv2StageLoggingEnabledRule) Name() string {
+	return "aws_apigatewayv2_stage_logging_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsApigatewayv2StageLoggingEnabledRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsApigatewayv2StageLoggingEnabledRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsApigatewayv2StageLoggingEnabledRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether the access_log_settings block is declared
+func (r *AwsApigatewayv2StageLoggingEnabledRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: r.blockName, Body: &hclext.BodySchema{}}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if err := checkLoggingBlock(runner, r, resource, r.blockName, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
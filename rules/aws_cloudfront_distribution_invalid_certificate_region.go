@@ -0,0 +1,150 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/aws"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// cloudfrontRegion is the only region where CloudFront accepts ACM certificates and WAFv2 resources
+const cloudfrontRegion = "us-east-1"
+
+// AwsCloudfrontDistributionInvalidCertificateRegionRule checks whether ACM certificates of CloudFront distributions are in us-east-1
+type AwsCloudfrontDistributionInvalidCertificateRegionRule struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	blockName     string
+	attributeName string
+	// certificates maps certificate resource types to the attributes that return the certificate ARN
+	certificates map[string]string
+}
+
+// NewAwsCloudfrontDistributionInvalidCertificateRegionRule returns new rule with default attributes
+func NewAwsCloudfrontDistributionInvalidCertificateRegionRule() *AwsCloudfrontDistributionInvalidCertificateRegionRule {
+	return &AwsCloudfrontDistributionInvalidCertificateRegionRule{
+		resourceType:  "aws_cloudfront_distribution",
+		blockName:     "viewer_certificate",
+		attributeName: "acm_certificate_arn",
+		certificates: map[string]string{
+			"aws_acm_certificate":            "arn",
+			"aws_acm_certificate_validation": "certificate_arn",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudfrontDistributionInvalidCertificateRegionRule) Name() string {
+	return "aws_cloudfront_distribution_invalid_certificate_region"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudfrontDistributionInvalidCertificateRegionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudfrontDistributionInvalidCertificateRegionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudfrontDistributionInvalidCertificateRegionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks the region of acm_certificate_arn.
+// References to aws_acm_certificate and aws_acm_certificate_validation are resolved to the provider region of the certificate,
+// and other values are checked by the region segment of the ARN.
+func (r *AwsCloudfrontDistributionInvalidCertificateRegionRule) Check(runner tflint.Runner) error {
+	regions, err := aws.NewRegionResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// certificateRegions maps the addresses of certificates to their provider regions
+	certificateRegions := map[string]string{}
+	for resourceType := range r.certificates {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "provider"}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			region := regions.ResourceRegion(resource)
+			certificateRegions[fmt.Sprintf("%s.%s", resourceType, resource.Labels[1])] = region
+		}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, block := range resource.Body.Blocks {
+			attribute, exists := block.Body.Attributes[r.attributeName]
+			if !exists {
+				continue
+			}
+
+			resolved := false
+			for resourceType, attributeName := range r.certificates {
+				address, ok := resourceReference(attribute.Expr, resourceType, attributeName)
+				if !ok {
+					continue
+				}
+				resolved = true
+
+				region := certificateRegions[address]
+				if region == "" || region == cloudfrontRegion {
+					break
+				}
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The certificate %s is in %s, but CloudFront only accepts certificates in %s`, address, region, cloudfrontRegion),
+					attribute.Expr.Range(),
+				); err != nil {
+					return err
+				}
+				break
+			}
+			if resolved {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(arn string) error {
+				parts := strings.SplitN(arn, ":", 6)
+				if len(parts) < 6 || parts[0] != "arn" || parts[3] == "" || parts[3] == cloudfrontRegion {
+					return nil
+				}
+
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The certificate "%s" is in %s, but CloudFront only accepts certificates in %s`, arn, parts[3], cloudfrontRegion),
+					attribute.Expr.Range(),
+				)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
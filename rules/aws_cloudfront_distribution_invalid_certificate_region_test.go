@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudfrontDistributionInvalidCertificateRegion(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "certificate created in the default provider region",
+			Content: `
+provider "aws" {
+  region = "eu-west-1"
+}
+
+resource "aws_acm_certificate" "main" {
+  domain_name = "example.com"
+}
+
+resource "aws_cloudfront_distribution" "main" {
+  viewer_certificate {
+    acm_certificate_arn = aws_acm_certificate.main.arn
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudfrontDistributionInvalidCertificateRegionRule(),
+					Message: `The certificate aws_acm_certificate.main is in eu-west-1, but CloudFront only accepts certificates in us-east-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 27},
+						End:      hcl.Pos{Line: 12, Column: 55},
+					},
+				},
+			},
+		},
+		{
+			Name: "certificate created in us-east-1",
+			Content: `
+provider "aws" {
+  region = "eu-west-1"
+}
+
+provider "aws" {
+  alias  = "us_east_1"
+  region = "us-east-1"
+}
+
+resource "aws_acm_certificate" "main" {
+  provider = aws.us_east_1
+
+  domain_name = "example.com"
+}
+
+resource "aws_acm_certificate_validation" "main" {
+  provider = aws.us_east_1
+
+  certificate_arn = aws_acm_certificate.main.arn
+}
+
+resource "aws_cloudfront_distribution" "main" {
+  viewer_certificate {
+    acm_certificate_arn = aws_acm_certificate_validation.main.certificate_arn
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "validation created in another region",
+			Content: `
+provider "aws" {
+  region = "eu-west-1"
+}
+
+resource "aws_acm_certificate_validation" "main" {
+  certificate_arn = "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"
+}
+
+resource "aws_cloudfront_distribution" "main" {
+  viewer_certificate {
+    acm_certificate_arn = aws_acm_certificate_validation.main.certificate_arn
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudfrontDistributionInvalidCertificateRegionRule(),
+					Message: `The certificate aws_acm_certificate_validation.main is in eu-west-1, but CloudFront only accepts certificates in us-east-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 27},
+						End:      hcl.Pos{Line: 12, Column: 78},
+					},
+				},
+			},
+		},
+		{
+			Name: "literal ARN",
+			Content: `
+resource "aws_cloudfront_distribution" "main" {
+  viewer_certificate {
+    acm_certificate_arn = "arn:aws:acm:eu-west-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudfrontDistributionInvalidCertificateRegionRule(),
+					Message: `The certificate "arn:aws:acm:eu-west-1:123456789012:certificate/12345678-1234-1234-1234-123456789012" is in eu-west-1, but CloudFront only accepts certificates in us-east-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 4, Column: 112},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown region",
+			Content: `
+resource "aws_acm_certificate" "main" {
+  domain_name = "example.com"
+}
+
+resource "aws_cloudfront_distribution" "main" {
+  viewer_certificate {
+    acm_certificate_arn = aws_acm_certificate.main.arn
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudfrontDistributionInvalidCertificateRegionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
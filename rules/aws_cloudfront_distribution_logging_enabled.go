@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsCloudfrontDistributionLoggingEnabledRule checks whether CloudFront distributions enable standard logging
+type AwsCloudfrontDistributionLoggingEnabledRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+	blockName    string
+}
+
+// NewAwsCloudfrontDistributionLoggingEnabledRule returns new rule with default attributes
+func NewAwsCloudfrontDistributionLoggingEnabledRule() *AwsCloudfrontDistributionLoggingEnabledRule {
+	return &AwsCloudfrontDistributionLoggingEnabledRule{
+		resourceType: "aws_cloudfront_distribution",
+		blockName:    "logging_config",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudfrontDistributionLoggingEnabledRule) Name() string {
+	return "aws_cloudfront_distribution_logging_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudfrontDistributionLoggingEnabledRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudfrontDistributionLoggingEnabledRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudfrontDistributionLoggingEnabledRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether the logging_config block is declared
+func (r *AwsCloudfrontDistributionLoggingEnabledRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: r.blockName, Body: &hclext.BodySchema{}}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if err := checkLoggingBlock(runner, r, resource, r.blockName, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudfrontDistributionLoggingEnabled(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "logging is not configured",
+			Content: `
+resource "aws_cloudfront_distribution" "distribution" {
+  enabled = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudfrontDistributionLoggingEnabledRule(),
+					Message: "`logging_config` block should be declared to enable logging",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 54},
+					},
+				},
+			},
+		},
+		{
+			Name: "logging is configured",
+			Content: `
+resource "aws_cloudfront_distribution" "distribution" {
+  enabled = true
+
+  logging_config {
+    bucket = "logs.s3.amazonaws.com"
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudfrontDistributionLoggingEnabledRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsCloudtrailBaselineRule checks whether trails are multi-region, validate log files and are encrypted with KMS
+type AwsCloudtrailBaselineRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+	// boolAttributes must be set to true
+	boolAttributes  []string
+	kmsKeyAttribute string
+}
+
+// NewAwsCloudtrailBaselineRule returns new rule with default attributes
+func NewAwsCloudtrailBaselineRule() *AwsCloudtrailBaselineRule {
+	return &AwsCloudtrailBaselineRule{
+		resourceType:    "aws_cloudtrail",
+		boolAttributes:  []string{"is_multi_region_trail", "enable_log_file_validation"},
+		kmsKeyAttribute: "kms_key_id",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudtrailBaselineRule) Name() string {
+	return "aws_cloudtrail_baseline"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudtrailBaselineRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudtrailBaselineRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudtrailBaselineRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether is_multi_region_trail and enable_log_file_validation are true and kms_key_id is set
+func (r *AwsCloudtrailBaselineRule) Check(runner tflint.Runner) error {
+	attributes := []hclext.AttributeSchema{{Name: r.kmsKeyAttribute}}
+	for _, name := range r.boolAttributes {
+		attributes = append(attributes, hclext.AttributeSchema{Name: name})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{Attributes: attributes}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, name := range r.boolAttributes {
+			attribute, exists := resource.Body.Attributes[name]
+			if !exists {
+				if err := runner.EmitIssue(r, fmt.Sprintf("`%s` should be set to true", name), resource.DefRange); err != nil {
+					return err
+				}
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(enabled bool) error {
+				if enabled {
+					return nil
+				}
+				return runner.EmitIssue(r, fmt.Sprintf("`%s` should be set to true", name), attribute.Expr.Range())
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, exists := resource.Body.Attributes[r.kmsKeyAttribute]; !exists {
+			if err := runner.EmitIssue(r, fmt.Sprintf("`%s` should be set to encrypt logs with a KMS key", r.kmsKeyAttribute), resource.DefRange); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudtrailBaseline(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing attributes",
+			Content: `
+resource "aws_cloudtrail" "trail" {
+  name           = "trail"
+  s3_bucket_name = "bucket"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudtrailBaselineRule(),
+					Message: "`is_multi_region_trail` should be set to true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 34},
+					},
+				},
+				{
+					Rule:    NewAwsCloudtrailBaselineRule(),
+					Message: "`enable_log_file_validation` should be set to true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 34},
+					},
+				},
+				{
+					Rule:    NewAwsCloudtrailBaselineRule(),
+					Message: "`kms_key_id` should be set to encrypt logs with a KMS key",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "disabled attributes",
+			Content: `
+resource "aws_cloudtrail" "trail" {
+  name                       = "trail"
+  s3_bucket_name             = "bucket"
+  is_multi_region_trail      = false
+  enable_log_file_validation = true
+  kms_key_id                 = "arn:aws:kms:us-east-1:111111111111:key/example"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudtrailBaselineRule(),
+					Message: "`is_multi_region_trail` should be set to true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 32},
+						End:      hcl.Pos{Line: 5, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "baseline",
+			Content: `
+resource "aws_cloudtrail" "trail" {
+  name                       = "trail"
+  s3_bucket_name             = "bucket"
+  is_multi_region_trail      = true
+  enable_log_file_validation = true
+  kms_key_id                 = "arn:aws:kms:us-east-1:111111111111:key/example"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudtrailBaselineRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,263 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"golang.org/x/exp/maps"
+)
+
+// AwsCloudwatchEventRuleInvalidEventPatternRule checks whether event patterns are well-formed
+type AwsCloudwatchEventRuleInvalidEventPatternRule struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsCloudwatchEventRuleInvalidEventPatternRule returns new rule with default attributes
+func NewAwsCloudwatchEventRuleInvalidEventPatternRule() *AwsCloudwatchEventRuleInvalidEventPatternRule {
+	return &AwsCloudwatchEventRuleInvalidEventPatternRule{
+		resourceType:  "aws_cloudwatch_event_rule",
+		attributeName: "event_pattern",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchEventRuleInvalidEventPatternRule) Name() string {
+	return "aws_cloudwatch_event_rule_invalid_event_pattern"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchEventRuleInvalidEventPatternRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchEventRuleInvalidEventPatternRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchEventRuleInvalidEventPatternRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether event_pattern is a JSON object whose fields match arrays of values or content filters
+func (r *AwsCloudwatchEventRuleInvalidEventPatternRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(pattern string) error {
+			var fields map[string]interface{}
+			if err := json.Unmarshal([]byte(pattern), &fields); err != nil {
+				return runner.EmitIssue(r, fmt.Sprintf("event_pattern is not a valid JSON object: %s", err), attribute.Expr.Range())
+			}
+			if len(fields) == 0 {
+				return runner.EmitIssue(r, "event_pattern must match at least one field", attribute.Expr.Range())
+			}
+
+			for _, message := range validateEventPatternFields(fields, "") {
+				if err := runner.EmitIssue(r, message, attribute.Expr.Range()); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateEventPatternFields returns messages for each malformed field of the pattern.
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html
+func validateEventPatternFields(fields map[string]interface{}, parent string) []string {
+	messages := []string{}
+
+	keys := maps.Keys(fields)
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		path := key
+		if parent != "" {
+			path = parent + "." + key
+		}
+
+		switch value := fields[key].(type) {
+		case map[string]interface{}:
+			if len(value) == 0 {
+				messages = append(messages, fmt.Sprintf(`"%s" must match at least one field`, path))
+				continue
+			}
+			messages = append(messages, validateEventPatternFields(value, path)...)
+		case []interface{}:
+			if key == "$or" {
+				messages = append(messages, validateEventPatternOr(value, parent)...)
+				continue
+			}
+			if len(value) == 0 {
+				messages = append(messages, fmt.Sprintf(`"%s" must match at least one value`, path))
+				continue
+			}
+			for _, element := range value {
+				if filter, ok := element.(map[string]interface{}); ok {
+					messages = append(messages, validateEventPatternContentFilter(filter, path)...)
+				}
+			}
+		default:
+			messages = append(messages, fmt.Sprintf(`"%s" must be an array of values or an object`, path))
+		}
+	}
+
+	return messages
+}
+
+// validateEventPatternOr returns messages if the "$or" operator is not an array of two or more patterns
+func validateEventPatternOr(patterns []interface{}, parent string) []string {
+	path := "$or"
+	if parent != "" {
+		path = parent + ".$or"
+	}
+	if len(patterns) < 2 {
+		return []string{fmt.Sprintf(`"%s" must have at least two patterns`, path)}
+	}
+
+	messages := []string{}
+	for _, pattern := range patterns {
+		fields, ok := pattern.(map[string]interface{})
+		if !ok || len(fields) == 0 {
+			messages = append(messages, fmt.Sprintf(`"%s" must be an array of objects that match at least one field`, path))
+			continue
+		}
+		messages = append(messages, validateEventPatternFields(fields, parent)...)
+	}
+	return messages
+}
+
+// validateEventPatternContentFilter returns messages if the content filter is not a single known filter with a valid value
+func validateEventPatternContentFilter(filter map[string]interface{}, path string) []string {
+	if len(filter) != 1 {
+		return []string{fmt.Sprintf(`The content filter in "%s" must have exactly one key`, path)}
+	}
+
+	for name, value := range filter {
+		valid := false
+		expected := ""
+
+		switch name {
+		case "prefix", "suffix":
+			expected = `a string or an object with "equals-ignore-case"`
+			valid = isEventPatternString(value) || isEventPatternIgnoreCaseFilter(value)
+		case "equals-ignore-case", "wildcard", "cidr":
+			expected = "a string"
+			valid = isEventPatternString(value)
+		case "exists":
+			expected = "a boolean"
+			_, valid = value.(bool)
+		case "numeric":
+			expected = `an array of operators and numbers such as [">", 0, "<=", 5]`
+			valid = isEventPatternNumericFilter(value)
+		case "anything-but":
+			expected = `a string, a number, an array of them or an object with "prefix", "suffix", "equals-ignore-case" or "wildcard"`
+			valid = isEventPatternAnythingButFilter(value)
+		default:
+			return []string{fmt.Sprintf(`"%s" is an unknown content filter in "%s"`, name, path)}
+		}
+
+		if !valid {
+			return []string{fmt.Sprintf(`The "%s" filter in "%s" must be %s`, name, path, expected)}
+		}
+	}
+	return []string{}
+}
+
+func isEventPatternString(value interface{}) bool {
+	_, ok := value.(string)
+	return ok
+}
+
+func isEventPatternIgnoreCaseFilter(value interface{}) bool {
+	filter, ok := value.(map[string]interface{})
+	if !ok || len(filter) != 1 {
+		return false
+	}
+	return isEventPatternString(filter["equals-ignore-case"])
+}
+
+func isEventPatternNumericFilter(value interface{}) bool {
+	conditions, ok := value.([]interface{})
+	if !ok || len(conditions) == 0 || len(conditions)%2 != 0 {
+		return false
+	}
+
+	for i := 0; i < len(conditions); i += 2 {
+		operator, ok := conditions[i].(string)
+		if !ok || !stringInSlice(operator, []string{"<", "<=", "=", ">", ">="}) {
+			return false
+		}
+		if _, ok := conditions[i+1].(float64); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func isEventPatternAnythingButFilter(value interface{}) bool {
+	switch value := value.(type) {
+	case string, float64:
+		return true
+	case []interface{}:
+		if len(value) == 0 {
+			return false
+		}
+		for _, element := range value {
+			switch element.(type) {
+			case string, float64:
+			default:
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		if len(value) != 1 {
+			return false
+		}
+		for name, operand := range value {
+			switch name {
+			case "prefix", "suffix", "equals-ignore-case", "wildcard":
+				if isEventPatternString(operand) {
+					return true
+				}
+				// equals-ignore-case and wildcard also accept an array of strings
+				elements, ok := operand.([]interface{})
+				if !ok || len(elements) == 0 || name == "prefix" || name == "suffix" {
+					return false
+				}
+				for _, element := range elements {
+					if !isEventPatternString(element) {
+						return false
+					}
+				}
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchEventRuleInvalidEventPattern(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "scalar values",
+			Content: `
+resource "aws_cloudwatch_event_rule" "rule" {
+  event_pattern = <<-EOF
+  {
+    "source": "aws.ec2",
+    "detail": {"state": []}
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleInvalidEventPatternRule(),
+					Message: `"detail.state" must match at least one value`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 8, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsCloudwatchEventRuleInvalidEventPatternRule(),
+					Message: `"source" must be an array of values or an object`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 8, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "content filters",
+			Content: `
+resource "aws_cloudwatch_event_rule" "rule" {
+  event_pattern = <<-EOF
+  {
+    "detail": {
+      "count": [{"numeric": [">", "0"]}],
+      "instance-id": [{"prefix": "i-", "suffix": "0"}],
+      "state": [{"anything-bot": "running"}],
+      "tag": [{"exists": "true"}]
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleInvalidEventPatternRule(),
+					Message: `The "numeric" filter in "detail.count" must be an array of operators and numbers such as [">", 0, "<=", 5]`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 12, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsCloudwatchEventRuleInvalidEventPatternRule(),
+					Message: `The content filter in "detail.instance-id" must have exactly one key`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 12, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsCloudwatchEventRuleInvalidEventPatternRule(),
+					Message: `"anything-bot" is an unknown content filter in "detail.state"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 12, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsCloudwatchEventRuleInvalidEventPatternRule(),
+					Message: `The "exists" filter in "detail.tag" must be a boolean`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 12, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "$or",
+			Content: `
+resource "aws_cloudwatch_event_rule" "rule" {
+  event_pattern = <<-EOF
+  {
+    "detail": {
+      "$or": [{"state": ["running"]}]
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleInvalidEventPatternRule(),
+					Message: `"detail.$or" must have at least two patterns`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 9, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "not an object",
+			Content: `
+resource "aws_cloudwatch_event_rule" "rule" {
+  event_pattern = "[\"aws.ec2\"]"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventRuleInvalidEventPatternRule(),
+					Message: "event_pattern is not a valid JSON object: json: cannot unmarshal array into Go value of type map[string]interface {}",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 3, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_cloudwatch_event_rule" "rule" {
+  event_pattern = <<-EOF
+  {
+    "source": ["aws.ec2"],
+    "detail-type": [{"prefix": {"equals-ignore-case": "ec2 instance"}}],
+    "detail": {
+      "state": [{"anything-but": ["pending", "stopping"]}],
+      "count": [{"numeric": [">", 0, "<=", 5]}],
+      "source-ip": [{"cidr": "10.0.0.0/24"}],
+      "$or": [
+        {"tag": [{"exists": true}]},
+        {"name": [{"wildcard": "web-*"}, null]}
+      ]
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchEventRuleInvalidEventPatternRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,172 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"golang.org/x/exp/maps"
+)
+
+// AwsCloudwatchEventTargetInvalidInputTransformerRule checks whether input and input_transformer of event targets are well-formed
+type AwsCloudwatchEventTargetInvalidInputTransformerRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+	// predefinedVariables are placeholders that can be used in input_template without input_paths
+	predefinedVariables []string
+	placeholderPattern  *regexp.Regexp
+}
+
+// NewAwsCloudwatchEventTargetInvalidInputTransformerRule returns new rule with default attributes
+func NewAwsCloudwatchEventTargetInvalidInputTransformerRule() *AwsCloudwatchEventTargetInvalidInputTransformerRule {
+	return &AwsCloudwatchEventTargetInvalidInputTransformerRule{
+		resourceType: "aws_cloudwatch_event_target",
+		predefinedVariables: []string{
+			"aws.events.rule-arn",
+			"aws.events.rule-name",
+			"aws.events.event",
+			"aws.events.event.json",
+			"aws.events.event.ingestion-time",
+		},
+		placeholderPattern: regexp.MustCompile(`<([A-Za-z0-9_.\-]+)>`),
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchEventTargetInvalidInputTransformerRule) Name() string {
+	return "aws_cloudwatch_event_target_invalid_input_transformer"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchEventTargetInvalidInputTransformerRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchEventTargetInvalidInputTransformerRule) Severity() tflint.Severity {
+	// Text in angle brackets such as HTML tags cannot be distinguished from placeholders
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchEventTargetInvalidInputTransformerRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether input is valid JSON, and input_template only uses placeholders defined in input_paths
+func (r *AwsCloudwatchEventTargetInvalidInputTransformerRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "input"}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "input_transformer",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "input_paths"}, {Name: "input_template"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if attribute, exists := resource.Body.Attributes["input"]; exists {
+			err := runner.EvaluateExpr(attribute.Expr, func(input string) error {
+				if json.Valid([]byte(input)) {
+					return nil
+				}
+				return runner.EmitIssue(r, "input is not valid JSON", attribute.Expr.Range())
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, transformer := range resource.Body.Blocks {
+			if err := r.checkInputTransformer(runner, transformer); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *AwsCloudwatchEventTargetInvalidInputTransformerRule) checkInputTransformer(runner tflint.Runner, transformer *hclext.Block) error {
+	// If input_paths is unknown, placeholders in input_template cannot be checked
+	paths := map[string]string{}
+	known := true
+
+	if attribute, exists := transformer.Body.Attributes["input_paths"]; exists {
+		known = false
+		err := runner.EvaluateExpr(attribute.Expr, func(val map[string]string) error {
+			paths = val
+			known = true
+
+			keys := maps.Keys(paths)
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				if strings.HasPrefix(paths[key], "$") {
+					continue
+				}
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The input path "%s" of "%s" must be a JSON path starting with "$"`, paths[key], key),
+					attribute.Expr.Range(),
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	attribute, exists := transformer.Body.Attributes["input_template"]
+	if !exists {
+		return nil
+	}
+
+	return runner.EvaluateExpr(attribute.Expr, func(template string) error {
+		if known {
+			undefined := []string{}
+			for _, match := range r.placeholderPattern.FindAllStringSubmatch(template, -1) {
+				name := match[1]
+				if _, exists := paths[name]; exists || stringInSlice(name, r.predefinedVariables) || stringInSlice(name, undefined) {
+					continue
+				}
+				undefined = append(undefined, name)
+			}
+
+			for _, name := range undefined {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The placeholder "<%s>" is not defined in input_paths`, name),
+					attribute.Expr.Range(),
+				); err != nil {
+					return err
+				}
+			}
+		}
+
+		// A template that looks like JSON must be valid JSON once placeholders are replaced
+		trimmed := strings.TrimSpace(template)
+		if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+			return nil
+		}
+		if json.Valid([]byte(r.placeholderPattern.ReplaceAllString(trimmed, "null"))) {
+			return nil
+		}
+		return runner.EmitIssue(r, "input_template is not valid JSON", attribute.Expr.Range())
+	}, nil)
+}
@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchEventTargetInvalidInputTransformer(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "undefined placeholders",
+			Content: `
+resource "aws_cloudwatch_event_target" "target" {
+  rule = "rule"
+  arn  = "arn:aws:sns:us-east-1:123456789012:topic"
+
+  input_transformer {
+    input_paths = {
+      instance = "detail.instance-id"
+      state    = "$.detail.state"
+    }
+    input_template = "\"<instance> is <status> in <aws.events.rule-name>\""
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetInvalidInputTransformerRule(),
+					Message: `The input path "detail.instance-id" of "instance" must be a JSON path starting with "$"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 19},
+						End:      hcl.Pos{Line: 10, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsCloudwatchEventTargetInvalidInputTransformerRule(),
+					Message: `The placeholder "<status>" is not defined in input_paths`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 22},
+						End:      hcl.Pos{Line: 11, Column: 76},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid JSON template",
+			Content: `
+resource "aws_cloudwatch_event_target" "target" {
+  rule = "rule"
+  arn  = "arn:aws:sns:us-east-1:123456789012:topic"
+
+  input_transformer {
+    input_paths = {
+      instance = "$.detail.instance-id"
+    }
+    input_template = <<-EOF
+    {"instance": <instance>, "state": running}
+    EOF
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetInvalidInputTransformerRule(),
+					Message: "input_template is not valid JSON",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 22},
+						End:      hcl.Pos{Line: 12, Column: 8},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid input",
+			Content: `
+resource "aws_cloudwatch_event_target" "target" {
+  rule  = "rule"
+  arn   = "arn:aws:sns:us-east-1:123456789012:topic"
+  input = "{\"state\": running}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchEventTargetInvalidInputTransformerRule(),
+					Message: "input is not valid JSON",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 11},
+						End:      hcl.Pos{Line: 5, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_cloudwatch_event_target" "target" {
+  rule = "rule"
+  arn  = "arn:aws:sns:us-east-1:123456789012:topic"
+
+  input_transformer {
+    input_paths = {
+      instance = "$.detail.instance-id"
+    }
+    input_template = <<-EOF
+    {"instance": <instance>, "message": "<instance> is stopped", "event": <aws.events.event.json>}
+    EOF
+  }
+}
+
+resource "aws_cloudwatch_event_target" "static" {
+  rule  = "rule"
+  arn   = "arn:aws:sns:us-east-1:123456789012:topic"
+  input = "{\"state\": \"running\"}"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchEventTargetInvalidInputTransformerRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
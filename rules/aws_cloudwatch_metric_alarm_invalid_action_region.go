@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/aws"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsCloudwatchMetricAlarmInvalidActionRegionRule checks whether alarm actions are in the region of the alarm
+type AwsCloudwatchMetricAlarmInvalidActionRegionRule struct {
+	tflint.DefaultRule
+
+	resourceType   string
+	attributeNames []string
+}
+
+// NewAwsCloudwatchMetricAlarmInvalidActionRegionRule returns new rule with default attributes
+func NewAwsCloudwatchMetricAlarmInvalidActionRegionRule() *AwsCloudwatchMetricAlarmInvalidActionRegionRule {
+	return &AwsCloudwatchMetricAlarmInvalidActionRegionRule{
+		resourceType:   "aws_cloudwatch_metric_alarm",
+		attributeNames: []string{"alarm_actions", "ok_actions", "insufficient_data_actions"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchMetricAlarmInvalidActionRegionRule) Name() string {
+	return "aws_cloudwatch_metric_alarm_invalid_action_region"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchMetricAlarmInvalidActionRegionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchMetricAlarmInvalidActionRegionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchMetricAlarmInvalidActionRegionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check compares the region in action ARNs with the region of the provider.
+// Alarms whose provider region cannot be determined are skipped.
+func (r *AwsCloudwatchMetricAlarmInvalidActionRegionRule) Check(runner tflint.Runner) error {
+	regions, err := aws.NewRegionResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, cloudwatchMetricAlarmSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		region := regions.ResourceRegion(resource)
+		if region == "" {
+			continue
+		}
+
+		for _, name := range r.attributeNames {
+			attribute, exists := resource.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(actions []string) error {
+				for _, action := range actions {
+					parts := strings.SplitN(action, ":", 5)
+					if len(parts) < 5 || parts[0] != "arn" || parts[3] == "" || parts[3] == region {
+						continue
+					}
+
+					if err := runner.EmitIssue(
+						r,
+						fmt.Sprintf(`The action "%s" is in %s, but the alarm is in %s`, action, parts[3], region),
+						attribute.Expr.Range(),
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchMetricAlarmInvalidActionRegion(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "different region",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  alarm_actions = ["arn:aws:sns:us-west-2:123456789012:alerts"]
+  ok_actions    = ["arn:aws:sns:us-east-1:123456789012:alerts"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidActionRegionRule(),
+					Message: `The action "arn:aws:sns:us-west-2:123456789012:alerts" is in us-west-2, but the alarm is in us-east-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 19},
+						End:      hcl.Pos{Line: 7, Column: 64},
+					},
+				},
+			},
+		},
+		{
+			Name: "aliased provider",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  provider = aws.west
+
+  alarm_actions             = ["arn:aws:sns:us-west-2:123456789012:alerts"]
+  insufficient_data_actions = ["arn:aws:sns:us-east-1:123456789012:alerts"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidActionRegionRule(),
+					Message: `The action "arn:aws:sns:us-east-1:123456789012:alerts" is in us-east-1, but the alarm is in us-west-2`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 31},
+						End:      hcl.Pos{Line: 15, Column: 76},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown region",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  alarm_actions = ["arn:aws:sns:us-west-2:123456789012:alerts"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchMetricAlarmInvalidActionRegionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsCloudwatchMetricAlarmInvalidMetricQueryRule checks whether exactly one metric query returns the data of the alarm
+type AwsCloudwatchMetricAlarmInvalidMetricQueryRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAwsCloudwatchMetricAlarmInvalidMetricQueryRule returns new rule with default attributes
+func NewAwsCloudwatchMetricAlarmInvalidMetricQueryRule() *AwsCloudwatchMetricAlarmInvalidMetricQueryRule {
+	return &AwsCloudwatchMetricAlarmInvalidMetricQueryRule{
+		resourceType: "aws_cloudwatch_metric_alarm",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchMetricAlarmInvalidMetricQueryRule) Name() string {
+	return "aws_cloudwatch_metric_alarm_invalid_metric_query"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchMetricAlarmInvalidMetricQueryRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchMetricAlarmInvalidMetricQueryRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchMetricAlarmInvalidMetricQueryRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks the number of metric_query blocks with `return_data = true`.
+// The query referred by threshold_metric_id returns the anomaly detection band in addition to the metric.
+func (r *AwsCloudwatchMetricAlarmInvalidMetricQueryRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, cloudwatchMetricAlarmSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if len(resource.Body.Blocks) == 0 {
+			continue
+		}
+
+		thresholdMetricID := ""
+		if attribute, exists := resource.Body.Attributes["threshold_metric_id"]; exists {
+			if err := runner.EvaluateExpr(attribute.Expr, func(id string) error {
+				thresholdMetricID = id
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+		}
+
+		count := 0
+		known := true
+		for _, query := range resource.Body.Blocks {
+			attribute, exists := query.Body.Attributes["return_data"]
+			if !exists {
+				continue
+			}
+
+			id := ""
+			if idAttr, exists := query.Body.Attributes["id"]; exists {
+				if err := runner.EvaluateExpr(idAttr.Expr, func(val string) error {
+					id = val
+					return nil
+				}, nil); err != nil {
+					return err
+				}
+			}
+
+			evaluated := false
+			err := runner.EvaluateExpr(attribute.Expr, func(returnData bool) error {
+				evaluated = true
+				if !returnData || (thresholdMetricID != "" && id == thresholdMetricID) {
+					return nil
+				}
+
+				count++
+				if count < 2 {
+					return nil
+				}
+				return runner.EmitIssue(
+					r,
+					"Only one `metric_query` block can set `return_data = true`",
+					attribute.Expr.Range(),
+				)
+			}, nil)
+			if err != nil {
+				return err
+			}
+			known = known && evaluated
+		}
+
+		if count == 0 && known {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("One of %d `metric_query` blocks should set `return_data = true`", len(resource.Body.Blocks)),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchMetricAlarmInvalidMetricQuery(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no return_data",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  metric_query {
+    id          = "e1"
+    expression  = "m1 * 100"
+    return_data = false
+  }
+
+  metric_query {
+    id = "m1"
+
+    metric {
+      metric_name = "Errors"
+      stat        = "Sum"
+    }
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidMetricQueryRule(),
+					Message: "One of 2 `metric_query` blocks should set `return_data = true`",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "multiple return_data",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  metric_query {
+    id          = "e1"
+    expression  = "m1 * 100"
+    return_data = true
+  }
+
+  metric_query {
+    id          = "m1"
+    return_data = true
+
+    metric {
+      metric_name = "Errors"
+      stat        = "Sum"
+    }
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidMetricQueryRule(),
+					Message: "Only one `metric_query` block can set `return_data = true`",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 19},
+						End:      hcl.Pos{Line: 11, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "anomaly detection",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  comparison_operator = "GreaterThanUpperThreshold"
+  threshold_metric_id = "e1"
+
+  metric_query {
+    id          = "e1"
+    expression  = "ANOMALY_DETECTION_BAND(m1)"
+    return_data = true
+  }
+
+  metric_query {
+    id          = "m1"
+    return_data = true
+
+    metric {
+      metric_name = "Errors"
+      stat        = "Sum"
+    }
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchMetricAlarmInvalidMetricQueryRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
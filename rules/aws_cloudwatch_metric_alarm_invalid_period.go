@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsCloudwatchMetricAlarmInvalidPeriodRule checks whether periods and evaluation ranges of alarms are valid
+type AwsCloudwatchMetricAlarmInvalidPeriodRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+	// highResolutionPeriods are periods shorter than a minute that can be used for high-resolution metrics
+	highResolutionPeriods []int
+}
+
+// NewAwsCloudwatchMetricAlarmInvalidPeriodRule returns new rule with default attributes
+func NewAwsCloudwatchMetricAlarmInvalidPeriodRule() *AwsCloudwatchMetricAlarmInvalidPeriodRule {
+	return &AwsCloudwatchMetricAlarmInvalidPeriodRule{
+		resourceType:          "aws_cloudwatch_metric_alarm",
+		highResolutionPeriods: []int{10, 20, 30},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchMetricAlarmInvalidPeriodRule) Name() string {
+	return "aws_cloudwatch_metric_alarm_invalid_period"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchMetricAlarmInvalidPeriodRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchMetricAlarmInvalidPeriodRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchMetricAlarmInvalidPeriodRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that periods are multiples of 60 or high-resolution periods,
+// and `evaluation_periods` * `period` does not exceed one day, or seven days for periods of an hour or longer.
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_PutMetricAlarm.html
+func (r *AwsCloudwatchMetricAlarmInvalidPeriodRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, cloudwatchMetricAlarmSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		// The period of the alarm is the longest period of the metrics
+		period := 0
+
+		attributes := []*hclext.Attribute{}
+		if attribute, exists := resource.Body.Attributes["period"]; exists {
+			attributes = append(attributes, attribute)
+		}
+		for _, query := range resource.Body.Blocks {
+			if attribute, exists := query.Body.Attributes["period"]; exists {
+				attributes = append(attributes, attribute)
+			}
+			for _, metric := range query.Body.Blocks {
+				if attribute, exists := metric.Body.Attributes["period"]; exists {
+					attributes = append(attributes, attribute)
+				}
+			}
+		}
+
+		for _, attribute := range attributes {
+			err := runner.EvaluateExpr(attribute.Expr, func(val int) error {
+				if val > period {
+					period = val
+				}
+				if (val > 0 && val%60 == 0) || intInSlice(val, r.highResolutionPeriods) {
+					return nil
+				}
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf("`period` is %d, but must be 10, 20, 30 or a multiple of 60", val),
+					attribute.Expr.Range(),
+				)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		attribute, exists := resource.Body.Attributes["evaluation_periods"]
+		if !exists || period == 0 {
+			continue
+		}
+
+		limit, description := 86400, "one day"
+		if period >= 3600 {
+			limit, description = 604800, "seven days"
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(evaluationPeriods int) error {
+			if evaluationPeriods*period <= limit {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("`evaluation_periods` * `period` is %d seconds and exceeds %s (%d seconds)", evaluationPeriods*period, description, limit),
+				attribute.Expr.Range(),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchMetricAlarmInvalidPeriod(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "invalid period",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  evaluation_periods = 2
+  period             = 90
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidPeriodRule(),
+					Message: "`period` is 90, but must be 10, 20, 30 or a multiple of 60",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 4, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "evaluation range longer than one day",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  evaluation_periods = 30
+
+  metric_query {
+    id          = "m1"
+    return_data = true
+
+    metric {
+      metric_name = "CPUUtilization"
+      period      = 3000
+      stat        = "Average"
+    }
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidPeriodRule(),
+					Message: "`evaluation_periods` * `period` is 90000 seconds and exceeds one day (86400 seconds)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "evaluation range longer than seven days",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  evaluation_periods = 8
+  period             = 86400
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidPeriodRule(),
+					Message: "`evaluation_periods` * `period` is 691200 seconds and exceeds seven days (604800 seconds)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 24},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "high_resolution" {
+  evaluation_periods = 6
+  period             = 10
+}
+
+resource "aws_cloudwatch_metric_alarm" "daily" {
+  evaluation_periods = 7
+  period             = 86400
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchMetricAlarmInvalidPeriodRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
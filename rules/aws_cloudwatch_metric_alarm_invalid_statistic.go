@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsCloudwatchMetricAlarmInvalidStatisticRule checks whether alarms on a single metric declare exactly one of statistic and extended_statistic
+type AwsCloudwatchMetricAlarmInvalidStatisticRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAwsCloudwatchMetricAlarmInvalidStatisticRule returns new rule with default attributes
+func NewAwsCloudwatchMetricAlarmInvalidStatisticRule() *AwsCloudwatchMetricAlarmInvalidStatisticRule {
+	return &AwsCloudwatchMetricAlarmInvalidStatisticRule{
+		resourceType: "aws_cloudwatch_metric_alarm",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchMetricAlarmInvalidStatisticRule) Name() string {
+	return "aws_cloudwatch_metric_alarm_invalid_statistic"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchMetricAlarmInvalidStatisticRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchMetricAlarmInvalidStatisticRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchMetricAlarmInvalidStatisticRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that statistic and extended_statistic are not set together, and one of them is set for metric_name.
+// Alarms based on metric_query blocks declare statistics in the queries instead.
+func (r *AwsCloudwatchMetricAlarmInvalidStatisticRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, cloudwatchMetricAlarmSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		statistic, err := isAttributeSet(runner, resource.Body, "statistic")
+		if err != nil {
+			return err
+		}
+		extendedStatistic, err := isAttributeSet(runner, resource.Body, "extended_statistic")
+		if err != nil {
+			return err
+		}
+		metricName, err := isAttributeSet(runner, resource.Body, "metric_name")
+		if err != nil {
+			return err
+		}
+
+		if statistic && extendedStatistic {
+			if err := runner.EmitIssue(
+				r,
+				"`statistic` and `extended_statistic` cannot be set together",
+				resource.Body.Attributes["extended_statistic"].Expr.Range(),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if metricName && !statistic && !extendedStatistic {
+			if err := runner.EmitIssue(
+				r,
+				"`statistic` or `extended_statistic` should be set for `metric_name`",
+				resource.Body.Attributes["metric_name"].Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchMetricAlarmInvalidStatistic(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "both statistics",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  metric_name        = "Latency"
+  statistic          = "Average"
+  extended_statistic = "p99"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidStatisticRule(),
+					Message: "`statistic` and `extended_statistic` cannot be set together",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 24},
+						End:      hcl.Pos{Line: 5, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "no statistic",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  metric_name = "Latency"
+  statistic   = null
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidStatisticRule(),
+					Message: "`statistic` or `extended_statistic` should be set for `metric_name`",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "statistic" {
+  metric_name = "Latency"
+  statistic   = "Average"
+}
+
+resource "aws_cloudwatch_metric_alarm" "extended_statistic" {
+  metric_name        = "Latency"
+  extended_statistic = "p99"
+}
+
+resource "aws_cloudwatch_metric_alarm" "metric_query" {
+  metric_query {
+    id          = "m1"
+    return_data = true
+
+    metric {
+      metric_name = "Latency"
+      stat        = "Average"
+    }
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchMetricAlarmInvalidStatisticRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsCloudwatchMetricAlarmInvalidThresholdRule checks whether the threshold matches the comparison operator
+type AwsCloudwatchMetricAlarmInvalidThresholdRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+	// anomalyDetectionOperators are comparison operators that compare metrics with anomaly detection bands
+	anomalyDetectionOperators []string
+}
+
+// NewAwsCloudwatchMetricAlarmInvalidThresholdRule returns new rule with default attributes
+func NewAwsCloudwatchMetricAlarmInvalidThresholdRule() *AwsCloudwatchMetricAlarmInvalidThresholdRule {
+	return &AwsCloudwatchMetricAlarmInvalidThresholdRule{
+		resourceType: "aws_cloudwatch_metric_alarm",
+		anomalyDetectionOperators: []string{
+			"LessThanLowerOrGreaterThanUpperThreshold",
+			"LessThanLowerThreshold",
+			"GreaterThanUpperThreshold",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudwatchMetricAlarmInvalidThresholdRule) Name() string {
+	return "aws_cloudwatch_metric_alarm_invalid_threshold"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudwatchMetricAlarmInvalidThresholdRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudwatchMetricAlarmInvalidThresholdRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudwatchMetricAlarmInvalidThresholdRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that anomaly detection operators use threshold_metric_id, and other operators use threshold
+func (r *AwsCloudwatchMetricAlarmInvalidThresholdRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, cloudwatchMetricAlarmSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes["comparison_operator"]
+		if !exists {
+			continue
+		}
+
+		threshold, err := isAttributeSet(runner, resource.Body, "threshold")
+		if err != nil {
+			return err
+		}
+		thresholdMetricID, err := isAttributeSet(runner, resource.Body, "threshold_metric_id")
+		if err != nil {
+			return err
+		}
+
+		err = runner.EvaluateExpr(attribute.Expr, func(operator string) error {
+			anomalyDetection := stringInSlice(operator, r.anomalyDetectionOperators)
+
+			switch {
+			case anomalyDetection && threshold:
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf("`threshold` cannot be set with %s. Use `threshold_metric_id` instead", operator),
+					resource.Body.Attributes["threshold"].Expr.Range(),
+				)
+			case anomalyDetection && !thresholdMetricID:
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf("`threshold_metric_id` should be set for %s", operator),
+					attribute.Expr.Range(),
+				)
+			case !anomalyDetection && thresholdMetricID:
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf("`threshold_metric_id` cannot be set with %s. Use an anomaly detection comparison operator instead", operator),
+					resource.Body.Attributes["threshold_metric_id"].Expr.Range(),
+				)
+			case !anomalyDetection && !threshold:
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf("`threshold` should be set for %s", operator),
+					attribute.Expr.Range(),
+				)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsCloudwatchMetricAlarmInvalidThreshold(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "anomaly detection with threshold",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  comparison_operator = "GreaterThanUpperThreshold"
+  threshold           = 80
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidThresholdRule(),
+					Message: "`threshold` cannot be set with GreaterThanUpperThreshold. Use `threshold_metric_id` instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 25},
+						End:      hcl.Pos{Line: 4, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "anomaly detection without threshold_metric_id",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  comparison_operator = "LessThanLowerThreshold"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidThresholdRule(),
+					Message: "`threshold_metric_id` should be set for LessThanLowerThreshold",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 25},
+						End:      hcl.Pos{Line: 3, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "static threshold with threshold_metric_id",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  comparison_operator = "GreaterThanThreshold"
+  threshold_metric_id = "e1"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidThresholdRule(),
+					Message: "`threshold_metric_id` cannot be set with GreaterThanThreshold. Use an anomaly detection comparison operator instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 25},
+						End:      hcl.Pos{Line: 4, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "static threshold without threshold",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "alarm" {
+  comparison_operator = "GreaterThanThreshold"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsCloudwatchMetricAlarmInvalidThresholdRule(),
+					Message: "`threshold` should be set for GreaterThanThreshold",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 25},
+						End:      hcl.Pos{Line: 3, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_cloudwatch_metric_alarm" "static" {
+  comparison_operator = "GreaterThanThreshold"
+  threshold           = 80
+}
+
+resource "aws_cloudwatch_metric_alarm" "anomaly_detection" {
+  comparison_operator = "LessThanLowerOrGreaterThanUpperThreshold"
+  threshold_metric_id = "e1"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsCloudwatchMetricAlarmInvalidThresholdRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
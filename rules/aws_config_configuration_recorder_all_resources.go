@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsConfigConfigurationRecorderAllResourcesRule checks whether configuration recorders record all supported resource types
+type AwsConfigConfigurationRecorderAllResourcesRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAwsConfigConfigurationRecorderAllResourcesRule returns new rule with default attributes
+func NewAwsConfigConfigurationRecorderAllResourcesRule() *AwsConfigConfigurationRecorderAllResourcesRule {
+	return &AwsConfigConfigurationRecorderAllResourcesRule{
+		resourceType: "aws_config_configuration_recorder",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsConfigConfigurationRecorderAllResourcesRule) Name() string {
+	return "aws_config_configuration_recorder_all_resources"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsConfigConfigurationRecorderAllResourcesRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsConfigConfigurationRecorderAllResourcesRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsConfigConfigurationRecorderAllResourcesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether recording_group records all supported resource types.
+// An omitted recording_group records all supported resource types.
+func (r *AwsConfigConfigurationRecorderAllResourcesRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "recording_group",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "all_supported"}},
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "recording_strategy",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: "use_only"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, group := range resource.Body.Blocks {
+			if attribute, exists := group.Body.Attributes["all_supported"]; exists {
+				err := runner.EvaluateExpr(attribute.Expr, func(allSupported bool) error {
+					if allSupported {
+						return nil
+					}
+					return runner.EmitIssue(r, "`all_supported` should be set to true to record all resource types", attribute.Expr.Range())
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, strategy := range group.Body.Blocks {
+				attribute, exists := strategy.Body.Attributes["use_only"]
+				if !exists {
+					continue
+				}
+
+				err := runner.EvaluateExpr(attribute.Expr, func(useOnly string) error {
+					if useOnly == "ALL_SUPPORTED_RESOURCE_TYPES" {
+						return nil
+					}
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf("`use_only` is %s, but should be ALL_SUPPORTED_RESOURCE_TYPES to record all resource types", useOnly),
+						attribute.Expr.Range(),
+					)
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsConfigConfigurationRecorderAllResources(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "all_supported is false",
+			Content: `
+resource "aws_config_configuration_recorder" "recorder" {
+  role_arn = "arn:aws:iam::111111111111:role/config"
+
+  recording_group {
+    all_supported  = false
+    resource_types = ["AWS::EC2::Instance"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsConfigConfigurationRecorderAllResourcesRule(),
+					Message: "`all_supported` should be set to true to record all resource types",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 22},
+						End:      hcl.Pos{Line: 6, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "exclusion strategy",
+			Content: `
+resource "aws_config_configuration_recorder" "recorder" {
+  role_arn = "arn:aws:iam::111111111111:role/config"
+
+  recording_group {
+    all_supported = false
+
+    exclusion_by_resource_types {
+      resource_types = ["AWS::EC2::Instance"]
+    }
+
+    recording_strategy {
+      use_only = "EXCLUSION_BY_RESOURCE_TYPES"
+    }
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsConfigConfigurationRecorderAllResourcesRule(),
+					Message: "`all_supported` should be set to true to record all resource types",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 21},
+						End:      hcl.Pos{Line: 6, Column: 26},
+					},
+				},
+				{
+					Rule:    NewAwsConfigConfigurationRecorderAllResourcesRule(),
+					Message: "`use_only` is EXCLUSION_BY_RESOURCE_TYPES, but should be ALL_SUPPORTED_RESOURCE_TYPES to record all resource types",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 13, Column: 18},
+						End:      hcl.Pos{Line: 13, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "all resource types",
+			Content: `
+resource "aws_config_configuration_recorder" "default" {
+  role_arn = "arn:aws:iam::111111111111:role/config"
+}
+
+resource "aws_config_configuration_recorder" "recorder" {
+  role_arn = "arn:aws:iam::111111111111:role/config"
+
+  recording_group {
+    all_supported                 = true
+    include_global_resource_types = true
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsConfigConfigurationRecorderAllResourcesRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
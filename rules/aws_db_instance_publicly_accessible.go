@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsDBInstancePubliclyAccessibleRule checks whether databases, caches and brokers are accessible from the internet
+type AwsDBInstancePubliclyAccessibleRule struct {
+	tflint.DefaultRule
+
+	// resourceTypes are resources that are exposed by `publicly_accessible = true`
+	resourceTypes []string
+	attributeName string
+	// vpcResourceTypes are resources that are exposed unless `vpc_options` is declared
+	vpcResourceTypes []string
+	vpcBlockName     string
+}
+
+type awsDBInstancePubliclyAccessibleRuleConfig struct {
+	Exceptions []string `hclext:"exceptions,optional"`
+}
+
+// NewAwsDBInstancePubliclyAccessibleRule returns new rule with default attributes
+func NewAwsDBInstancePubliclyAccessibleRule() *AwsDBInstancePubliclyAccessibleRule {
+	return &AwsDBInstancePubliclyAccessibleRule{
+		resourceTypes: []string{
+			"aws_db_instance",
+			"aws_rds_cluster_instance",
+			"aws_redshift_cluster",
+			"aws_dms_replication_instance",
+			"aws_mq_broker",
+		},
+		attributeName:    "publicly_accessible",
+		vpcResourceTypes: []string{"aws_opensearch_domain"},
+		vpcBlockName:     "vpc_options",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsDBInstancePubliclyAccessibleRule) Name() string {
+	return "aws_db_instance_publicly_accessible"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsDBInstancePubliclyAccessibleRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsDBInstancePubliclyAccessibleRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsDBInstancePubliclyAccessibleRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether publicly_accessible is true, or vpc_options is omitted for OpenSearch domains
+func (r *AwsDBInstancePubliclyAccessibleRule) Check(runner tflint.Runner) error {
+	config := awsDBInstancePubliclyAccessibleRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	for _, resourceType := range r.resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			if stringInSlice(fmt.Sprintf("%s.%s", resourceType, resource.Labels[1]), config.Exceptions) {
+				continue
+			}
+
+			attribute, exists := resource.Body.Attributes[r.attributeName]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(publiclyAccessible bool) error {
+				if !publiclyAccessible {
+					return nil
+				}
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` is publicly accessible from the internet", resourceType),
+					attribute.Expr.Range(),
+				)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, resourceType := range r.vpcResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Blocks: []hclext.BlockSchema{{Type: r.vpcBlockName, Body: &hclext.BodySchema{}}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			if stringInSlice(fmt.Sprintf("%s.%s", resourceType, resource.Labels[1]), config.Exceptions) {
+				continue
+			}
+			if len(resource.Body.Blocks) > 0 {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` without `%s` has a public endpoint", resourceType, r.vpcBlockName),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsDBInstancePubliclyAccessible(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "publicly accessible",
+			Content: `
+resource "aws_db_instance" "db" {
+  instance_class      = "db.t3.micro"
+  publicly_accessible = true
+}
+
+resource "aws_redshift_cluster" "cluster" {
+  cluster_identifier  = "cluster"
+  publicly_accessible = true
+}
+
+resource "aws_mq_broker" "broker" {
+  broker_name         = "broker"
+  publicly_accessible = false
+}
+
+resource "aws_opensearch_domain" "domain" {
+  domain_name = "domain"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsDBInstancePubliclyAccessibleRule(),
+					Message: "`aws_db_instance` is publicly accessible from the internet",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 25},
+						End:      hcl.Pos{Line: 4, Column: 29},
+					},
+				},
+				{
+					Rule:    NewAwsDBInstancePubliclyAccessibleRule(),
+					Message: "`aws_redshift_cluster` is publicly accessible from the internet",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 25},
+						End:      hcl.Pos{Line: 9, Column: 29},
+					},
+				},
+				{
+					Rule:    NewAwsDBInstancePubliclyAccessibleRule(),
+					Message: "`aws_opensearch_domain` without `vpc_options` has a public endpoint",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 17, Column: 1},
+						End:      hcl.Pos{Line: 17, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "exceptions",
+			Content: `
+resource "aws_rds_cluster_instance" "public" {
+  instance_class      = "db.r6g.large"
+  publicly_accessible = true
+}
+
+resource "aws_dms_replication_instance" "dms" {
+  replication_instance_id = "dms"
+  publicly_accessible     = true
+}
+
+resource "aws_opensearch_domain" "public" {
+  domain_name = "public"
+}
+
+resource "aws_opensearch_domain" "private" {
+  domain_name = "private"
+
+  vpc_options {
+    subnet_ids = ["subnet-12345678"]
+  }
+}
+`,
+			Config: `
+rule "aws_db_instance_publicly_accessible" {
+  enabled    = true
+  exceptions = ["aws_rds_cluster_instance.public", "aws_opensearch_domain.public"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsDBInstancePubliclyAccessibleRule(),
+					Message: "`aws_dms_replication_instance` is publicly accessible from the internet",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 29},
+						End:      hcl.Pos{Line: 9, Column: 33},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsDBInstancePubliclyAccessibleRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
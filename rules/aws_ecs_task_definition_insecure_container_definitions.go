@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsECSTaskDefinitionInsecureContainerDefinitionsRule checks whether container definitions follow security best practices
+type AwsECSTaskDefinitionInsecureContainerDefinitionsRule struct {
+	tflint.DefaultRule
+
+	defaultSecretNamePattern string
+}
+
+type awsECSTaskDefinitionInsecureContainerDefinitionsRuleConfig struct {
+	SecretNamePattern string `hclext:"secret_name_pattern,optional"`
+}
+
+// NewAwsECSTaskDefinitionInsecureContainerDefinitionsRule returns new rule with default attributes
+func NewAwsECSTaskDefinitionInsecureContainerDefinitionsRule() *AwsECSTaskDefinitionInsecureContainerDefinitionsRule {
+	return &AwsECSTaskDefinitionInsecureContainerDefinitionsRule{
+		defaultSecretNamePattern: `(?i)(password|passwd|secret|token|api_?key|private_?key|credential)`,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsECSTaskDefinitionInsecureContainerDefinitionsRule) Name() string {
+	return "aws_ecs_task_definition_insecure_container_definitions"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsECSTaskDefinitionInsecureContainerDefinitionsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsECSTaskDefinitionInsecureContainerDefinitionsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsECSTaskDefinitionInsecureContainerDefinitionsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks for privileged containers, writable root filesystems and secrets in environment variables.
+// Invalid container definitions are reported by aws_ecs_task_definition_invalid_container_definitions.
+func (r *AwsECSTaskDefinitionInsecureContainerDefinitionsRule) Check(runner tflint.Runner) error {
+	config := awsECSTaskDefinitionInsecureContainerDefinitionsRuleConfig{SecretNamePattern: r.defaultSecretNamePattern}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	secretNamePattern, err := regexp.Compile(config.SecretNamePattern)
+	if err != nil {
+		return fmt.Errorf("failed to compile secret_name_pattern: %w", err)
+	}
+
+	return walkECSContainerDefinitions(runner, func(resource *hclext.Block, attribute *hclext.Attribute, definitions []*ecsContainerDefinition, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		for _, definition := range definitions {
+			if definition.Privileged != nil && *definition.Privileged {
+				if err := runner.EmitIssue(r, fmt.Sprintf(`The container definition "%s" should not be privileged`, definition.Name), attribute.Expr.Range()); err != nil {
+					return err
+				}
+			}
+
+			if definition.ReadonlyRootFilesystem == nil || !*definition.ReadonlyRootFilesystem {
+				if err := runner.EmitIssue(r, fmt.Sprintf(`The container definition "%s" should set readonlyRootFilesystem to true`, definition.Name), attribute.Expr.Range()); err != nil {
+					return err
+				}
+			}
+
+			for _, variable := range definition.Environment {
+				if !secretNamePattern.MatchString(string(variable.Name)) {
+					continue
+				}
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The container definition "%s" passes "%s" in environment. Use secrets instead`, definition.Name, variable.Name),
+					attribute.Expr.Range(),
+				); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
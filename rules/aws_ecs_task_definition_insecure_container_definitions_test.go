@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsECSTaskDefinitionInsecureContainerDefinitions(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "insecure definitions",
+			Content: `
+resource "aws_ecs_task_definition" "task" {
+  family                = "task"
+  container_definitions = <<-EOF
+[
+  {
+    "name": "app",
+    "image": "app:latest",
+    "privileged": true,
+    "readonlyRootFilesystem": true,
+    "environment": [
+      {"name": "DB_PASSWORD", "value": "hunter2"},
+      {"name": "LOG_LEVEL", "value": "info"}
+    ]
+  },
+  {
+    "name": "sidecar",
+    "image": "sidecar:latest"
+  }
+]
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsECSTaskDefinitionInsecureContainerDefinitionsRule(),
+					Message: `The container definition "app" should not be privileged`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 21, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsECSTaskDefinitionInsecureContainerDefinitionsRule(),
+					Message: `The container definition "app" passes "DB_PASSWORD" in environment. Use secrets instead`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 21, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsECSTaskDefinitionInsecureContainerDefinitionsRule(),
+					Message: `The container definition "sidecar" should set readonlyRootFilesystem to true`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 21, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom secret name pattern",
+			Content: `
+resource "aws_ecs_task_definition" "task" {
+  family                = "task"
+  container_definitions = <<-EOF
+[
+  {
+    "name": "app",
+    "image": "app:latest",
+    "readonlyRootFilesystem": true,
+    "environment": [
+      {"name": "DB_PASSWORD_FILE", "value": "/run/secrets/db"},
+      {"name": "LICENSE", "value": "abc"}
+    ]
+  }
+]
+EOF
+}
+`,
+			Config: `
+rule "aws_ecs_task_definition_insecure_container_definitions" {
+  enabled             = true
+  secret_name_pattern = "^LICENSE$"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsECSTaskDefinitionInsecureContainerDefinitionsRule(),
+					Message: `The container definition "app" passes "LICENSE" in environment. Use secrets instead`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 16, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "null container definition",
+			Content: `
+resource "aws_ecs_task_definition" "task" {
+  family                = "task"
+  container_definitions = "[null]"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsECSTaskDefinitionInsecureContainerDefinitionsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
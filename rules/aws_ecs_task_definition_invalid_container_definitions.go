@@ -0,0 +1,160 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsECSTaskDefinitionInvalidContainerDefinitionsRule checks whether container definitions are valid
+type AwsECSTaskDefinitionInvalidContainerDefinitionsRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule returns new rule with default attributes
+func NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule() *AwsECSTaskDefinitionInvalidContainerDefinitionsRule {
+	return &AwsECSTaskDefinitionInvalidContainerDefinitionsRule{}
+}
+
+// Name returns the rule name
+func (r *AwsECSTaskDefinitionInvalidContainerDefinitionsRule) Name() string {
+	return "aws_ecs_task_definition_invalid_container_definitions"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsECSTaskDefinitionInvalidContainerDefinitionsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsECSTaskDefinitionInvalidContainerDefinitionsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsECSTaskDefinitionInvalidContainerDefinitionsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether container_definitions is valid JSON with known keys, fits in the task size,
+// and declares port mappings allowed by the network mode
+func (r *AwsECSTaskDefinitionInvalidContainerDefinitionsRule) Check(runner tflint.Runner) error {
+	return walkECSContainerDefinitions(runner, func(resource *hclext.Block, attribute *hclext.Attribute, definitions []*ecsContainerDefinition, err error) error {
+		if err != nil {
+			return runner.EmitIssue(r, fmt.Sprintf("container_definitions is not a valid JSON array of container definitions: %s", err), attribute.Expr.Range())
+		}
+
+		for _, definition := range definitions {
+			for _, key := range definition.Keys {
+				if ecsContainerDefinitionKeys[strings.ToLower(key)] {
+					continue
+				}
+				if err := runner.EmitIssue(r, fmt.Sprintf(`"%s" is an unknown key of the container definition "%s"`, key, definition.Name), attribute.Expr.Range()); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := r.checkTaskSize(runner, resource, attribute, definitions); err != nil {
+			return err
+		}
+		return r.checkPortMappings(runner, resource, attribute, definitions)
+	})
+}
+
+// checkTaskSize checks whether container memory and the sum of container CPU units exceed the task-level values
+func (r *AwsECSTaskDefinitionInvalidContainerDefinitionsRule) checkTaskSize(runner tflint.Runner, resource *hclext.Block, attribute *hclext.Attribute, definitions []*ecsContainerDefinition) error {
+	if memoryAttr, exists := resource.Body.Attributes["memory"]; exists {
+		err := runner.EvaluateExpr(memoryAttr.Expr, func(val string) error {
+			taskMemory, err := parseECSTaskSize(val, "GB")
+			if err != nil {
+				return nil
+			}
+
+			for _, definition := range definitions {
+				for _, limit := range []struct {
+					key    string
+					memory *ecsInt
+				}{
+					{key: "memory", memory: definition.Memory},
+					{key: "memoryReservation", memory: definition.MemoryReservation},
+				} {
+					if limit.memory == nil || int(*limit.memory) <= taskMemory {
+						continue
+					}
+					if err := runner.EmitIssue(
+						r,
+						fmt.Sprintf(`%s of the container definition "%s" is %d MiB and exceeds the task memory %d MiB`, limit.key, definition.Name, *limit.memory, taskMemory),
+						attribute.Expr.Range(),
+					); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cpuAttr, exists := resource.Body.Attributes["cpu"]; exists {
+		err := runner.EvaluateExpr(cpuAttr.Expr, func(val string) error {
+			taskCPU, err := parseECSTaskSize(val, "vCPU")
+			if err != nil {
+				return nil
+			}
+
+			total := 0
+			for _, definition := range definitions {
+				total += int(definition.CPU)
+			}
+			if total <= taskCPU {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("The total cpu of the container definitions is %d units and exceeds the task cpu %d units", total, taskCPU),
+				attribute.Expr.Range(),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkPortMappings checks whether hostPort equals containerPort in the awsvpc network mode
+func (r *AwsECSTaskDefinitionInvalidContainerDefinitionsRule) checkPortMappings(runner tflint.Runner, resource *hclext.Block, attribute *hclext.Attribute, definitions []*ecsContainerDefinition) error {
+	networkModeAttr, exists := resource.Body.Attributes["network_mode"]
+	if !exists {
+		return nil
+	}
+
+	return runner.EvaluateExpr(networkModeAttr.Expr, func(networkMode string) error {
+		if networkMode != "awsvpc" {
+			return nil
+		}
+
+		for _, definition := range definitions {
+			for _, mapping := range definition.PortMappings {
+				if mapping.HostPort == nil || mapping.ContainerPort == nil || *mapping.HostPort == *mapping.ContainerPort {
+					continue
+				}
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(`hostPort %d of the container definition "%s" must be omitted or equal to containerPort %d in the awsvpc network mode`, *mapping.HostPort, definition.Name, *mapping.ContainerPort),
+					attribute.Expr.Range(),
+				); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}, nil)
+}
@@ -0,0 +1,212 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsECSTaskDefinitionInvalidContainerDefinitions(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "invalid JSON",
+			Content: `
+resource "aws_ecs_task_definition" "task" {
+  family                = "task"
+  container_definitions = "[{\"name\": \"app\",}]"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule(),
+					Message: "container_definitions is not a valid JSON array of container definitions: invalid character '}' looking for beginning of object key string",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 4, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "null container definition",
+			Content: `
+resource "aws_ecs_task_definition" "task" {
+  family                = "task"
+  container_definitions = "[null]"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule(),
+					Message: "container_definitions is not a valid JSON array of container definitions: the container definition at index 0 is null",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 4, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "values declared as strings and numbers",
+			Content: `
+resource "aws_ecs_task_definition" "task" {
+  family                = "task"
+  cpu                   = "256"
+  container_definitions = <<-EOF
+[
+  {
+    "name": "app",
+    "image": "app:latest",
+    "cpu": "512",
+    "essential": true,
+    "environment": [{"name": "WORKERS", "value": 1}]
+  }
+]
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule(),
+					Message: "The total cpu of the container definitions is 512 units and exceeds the task cpu 256 units",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 27},
+						End:      hcl.Pos{Line: 15, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown keys and task size",
+			Content: `
+resource "aws_ecs_task_definition" "task" {
+  family                = "task"
+  cpu                   = "256"
+  memory                = "0.5 GB"
+  container_definitions = <<-EOF
+[
+  {
+    "name": "app",
+    "image": "app:latest",
+    "cpu": 256,
+    "memory": 1024,
+    "environmentVariables": []
+  },
+  {
+    "Name": "sidecar",
+    "Image": "sidecar:latest",
+    "cpu": 128,
+    "memoryReservation": 128
+  }
+]
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule(),
+					Message: `"environmentVariables" is an unknown key of the container definition "app"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 27},
+						End:      hcl.Pos{Line: 22, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule(),
+					Message: `memory of the container definition "app" is 1024 MiB and exceeds the task memory 512 MiB`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 27},
+						End:      hcl.Pos{Line: 22, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule(),
+					Message: "The total cpu of the container definitions is 384 units and exceeds the task cpu 256 units",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 27},
+						End:      hcl.Pos{Line: 22, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "port mappings in awsvpc",
+			Content: `
+resource "aws_ecs_task_definition" "task" {
+  family                = "task"
+  network_mode          = "awsvpc"
+  container_definitions = <<-EOF
+[
+  {
+    "name": "app",
+    "image": "app:latest",
+    "portMappings": [
+      {"containerPort": 80, "hostPort": 8080},
+      {"containerPort": 443, "hostPort": 443},
+      {"containerPort": 9000}
+    ]
+  }
+]
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule(),
+					Message: `hostPort 8080 of the container definition "app" must be omitted or equal to containerPort 80 in the awsvpc network mode`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 27},
+						End:      hcl.Pos{Line: 17, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_ecs_task_definition" "task" {
+  family                = "task"
+  network_mode          = "bridge"
+  cpu                   = "1 vCPU"
+  memory                = "2048"
+  container_definitions = <<-EOF
+[
+  {
+    "name": "app",
+    "image": "app:latest",
+    "cpu": 1024,
+    "memory": 2048,
+    "portMappings": [{"containerPort": 80, "hostPort": 8080}]
+  }
+]
+EOF
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
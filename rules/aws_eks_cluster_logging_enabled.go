@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsEksClusterLoggingEnabledRule checks whether EKS clusters enable control plane logging
+type AwsEksClusterLoggingEnabledRule struct {
+	tflint.DefaultRule
+
+	resourceType    string
+	attributeName   string
+	defaultLogTypes []string
+}
+
+// NewAwsEksClusterLoggingEnabledRule returns new rule with default attributes
+func NewAwsEksClusterLoggingEnabledRule() *AwsEksClusterLoggingEnabledRule {
+	return &AwsEksClusterLoggingEnabledRule{
+		resourceType:    "aws_eks_cluster",
+		attributeName:   "enabled_cluster_log_types",
+		defaultLogTypes: []string{"api", "audit"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsEksClusterLoggingEnabledRule) Name() string {
+	return "aws_eks_cluster_logging_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsEksClusterLoggingEnabledRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsEksClusterLoggingEnabledRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsEksClusterLoggingEnabledRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether enabled_cluster_log_types includes the required log types
+func (r *AwsEksClusterLoggingEnabledRule) Check(runner tflint.Runner) error {
+	required, err := decodeRequiredLogTypes(runner, r, r.defaultLogTypes)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if err := checkLogTypesAttribute(runner, r, resource, r.attributeName, required); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsEksClusterLoggingEnabled(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "logging is not enabled",
+			Content: `
+resource "aws_eks_cluster" "cluster" {
+  name = "cluster"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsEksClusterLoggingEnabledRule(),
+					Message: "`enabled_cluster_log_types` should enable \"api\", \"audit\"",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "required log types",
+			Content: `
+resource "aws_eks_cluster" "cluster" {
+  name                      = "cluster"
+  enabled_cluster_log_types = ["api", "audit"]
+}
+`,
+			Config: `
+rule "aws_eks_cluster_logging_enabled" {
+  enabled   = true
+  log_types = ["api", "audit", "authenticator"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsEksClusterLoggingEnabledRule(),
+					Message: "`enabled_cluster_log_types` should enable \"authenticator\"",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 31},
+						End:      hcl.Pos{Line: 4, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "logging is enabled",
+			Content: `
+resource "aws_eks_cluster" "cluster" {
+  name                      = "cluster"
+  enabled_cluster_log_types = ["api", "audit", "scheduler"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsEksClusterLoggingEnabledRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsGuarddutyDetectorEnabledRule checks whether GuardDuty detectors are enabled
+type AwsGuarddutyDetectorEnabledRule struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsGuarddutyDetectorEnabledRule returns new rule with default attributes
+func NewAwsGuarddutyDetectorEnabledRule() *AwsGuarddutyDetectorEnabledRule {
+	return &AwsGuarddutyDetectorEnabledRule{
+		resourceType:  "aws_guardduty_detector",
+		attributeName: "enable",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsGuarddutyDetectorEnabledRule) Name() string {
+	return "aws_guardduty_detector_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsGuarddutyDetectorEnabledRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsGuarddutyDetectorEnabledRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsGuarddutyDetectorEnabledRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether enable is not false. The detector is enabled if the attribute is omitted.
+func (r *AwsGuarddutyDetectorEnabledRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(enable bool) error {
+			if enable {
+				return nil
+			}
+			return runner.EmitIssue(r, "`enable` should be set to true", attribute.Expr.Range())
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsGuarddutyDetectorEnabled(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "disabled",
+			Content: `
+resource "aws_guardduty_detector" "detector" {
+  enable = false
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsGuarddutyDetectorEnabledRule(),
+					Message: "`enable` should be set to true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 12},
+						End:      hcl.Pos{Line: 3, Column: 17},
+					},
+				},
+			},
+		},
+		{
+			Name: "enabled",
+			Content: `
+resource "aws_guardduty_detector" "detector" {
+  enable = true
+}
+
+resource "aws_guardduty_detector" "default" {
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsGuarddutyDetectorEnabledRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -1,29 +1,26 @@
 package rules
 
 import (
-	"fmt"
-	"regexp"
-
-	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/terraform-linters/tflint-ruleset-aws/project"
 )
 
-// AwsIAMGroupPolicyTooLongRule checks that the policy length is less than 5,120 characters
+// AwsIAMGroupPolicyTooLongRule checks that the policy length is less than 5,120 characters.
+// This rule is deprecated in favor of aws_policy_too_long, which also checks the limit.
 type AwsIAMGroupPolicyTooLongRule struct {
 	tflint.DefaultRule
 
-	resourceType    string
-	attributeName   string
-	whitespaceRegex *regexp.Regexp
+	limit policyLengthLimit
 }
 
 // NewAwsIAMGroupPolicyTooLongRule returns new rule with default attributes
 func NewAwsIAMGroupPolicyTooLongRule() *AwsIAMGroupPolicyTooLongRule {
 	return &AwsIAMGroupPolicyTooLongRule{
-		resourceType:    "aws_iam_group_policy",
-		attributeName:   "policy",
-		whitespaceRegex: regexp.MustCompile(`\s+`),
+		limit: policyLengthLimit{
+			resourceType:  "aws_iam_group_policy",
+			attributeName: "policy",
+			limit:         5120,
+		},
 	}
 }
 
@@ -34,7 +31,7 @@ func (r *AwsIAMGroupPolicyTooLongRule) Name() string {
 
 // Enabled returns whether the rule is enabled by default
 func (r *AwsIAMGroupPolicyTooLongRule) Enabled() bool {
-	return true
+	return false
 }
 
 // Severity returns the rule severity
@@ -49,34 +46,9 @@ func (r *AwsIAMGroupPolicyTooLongRule) Link() string {
 
 // Check checks the length of the policy
 func (r *AwsIAMGroupPolicyTooLongRule) Check(runner tflint.Runner) error {
-	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
-	}, nil)
+	checker, err := newPolicyLengthChecker(runner)
 	if err != nil {
 		return err
 	}
-
-	for _, resource := range resources.Blocks {
-		attribute, exists := resource.Body.Attributes[r.attributeName]
-		if !exists {
-			continue
-		}
-
-		err := runner.EvaluateExpr(attribute.Expr, func(policy string) error {
-			policy = r.whitespaceRegex.ReplaceAllString(policy, "")
-			if len(policy) > 5120 {
-				runner.EmitIssue(
-					r,
-					fmt.Sprintf("The policy length is %d characters and is limited to 5120 characters.", len(policy)),
-					attribute.Expr.Range(),
-				)
-			}
-			return nil
-		}, nil)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return checker.check(r, r.limit)
 }
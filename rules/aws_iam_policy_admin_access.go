@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsIAMPolicyAdminAccessRule checks whether policy statements allow all actions on all resources
+type AwsIAMPolicyAdminAccessRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsIAMPolicyAdminAccessRule returns new rule with default attributes
+func NewAwsIAMPolicyAdminAccessRule() *AwsIAMPolicyAdminAccessRule {
+	return &AwsIAMPolicyAdminAccessRule{}
+}
+
+// Name returns the rule name
+func (r *AwsIAMPolicyAdminAccessRule) Name() string {
+	return "aws_iam_policy_admin_access"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsIAMPolicyAdminAccessRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsIAMPolicyAdminAccessRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsIAMPolicyAdminAccessRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each statement allows all actions on all resources.
+// Wildcards (`"*"` and `"*:*"`) and NotAction/NotResource without Action/Resource are considered.
+func (r *AwsIAMPolicyAdminAccessRule) Check(runner tflint.Runner) error {
+	return walkIAMPolicyDocuments(runner, func(statement *iamPolicyStatement) error {
+		if !statement.IsAllow() {
+			return nil
+		}
+
+		var actions string
+		switch {
+		case statement.Action.Contains("*"):
+			actions = `all actions ("*")`
+		case statement.Action.Contains("*:*"):
+			actions = `all actions ("*:*")`
+		case len(statement.Action) == 0 && len(statement.NotAction) > 0:
+			actions = "all actions except NotAction"
+		default:
+			return nil
+		}
+
+		var resources string
+		switch {
+		case statement.Resource.Contains("*"):
+			resources = `all resources ("*")`
+		case len(statement.Resource) == 0 && len(statement.NotResource) > 0:
+			resources = "all resources except NotResource"
+		default:
+			return nil
+		}
+
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("The policy statement allows %s on %s.", actions, resources),
+			statement.Range,
+		)
+	})
+}
@@ -0,0 +1,178 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsIAMPolicyAdminAccess(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "admin access in JSON policy",
+			Content: `
+resource "aws_iam_policy" "policy" {
+  name = "test_policy"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyAdminAccessRule(),
+					Message: `The policy statement allows all actions ("*") on all resources ("*").`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 15, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "admin access in policy document",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    actions   = ["*"]
+    resources = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyAdminAccessRule(),
+					Message: `The policy statement allows all actions ("*") on all resources ("*").`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "service and action wildcard",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    actions   = ["*:*"]
+    resources = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyAdminAccessRule(),
+					Message: `The policy statement allows all actions ("*:*") on all resources ("*").`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "NotAction and NotResource",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    not_actions   = ["iam:*"]
+    not_resources = ["arn:aws:s3:::audit/*"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyAdminAccessRule(),
+					Message: `The policy statement allows all actions except NotAction on all resources except NotResource.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "deny all",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    effect    = "Deny"
+    actions   = ["*"]
+    resources = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "effect cannot be evaluated",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    effect    = module.policy.effect
+    actions   = ["*"]
+    resources = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "scoped resources",
+			Content: `
+resource "aws_iam_role_policy" "policy" {
+  name = "test_policy"
+  role = "test_role"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": [
+        "arn:aws:s3:::bucket/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsIAMPolicyAdminAccessRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := newUnknownModuleRunner(t, map[string]string{"resource.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsIAMPolicyAllowNotActionRule checks whether policy statements combine NotAction with Allow
+type AwsIAMPolicyAllowNotActionRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsIAMPolicyAllowNotActionRule returns new rule with default attributes
+func NewAwsIAMPolicyAllowNotActionRule() *AwsIAMPolicyAllowNotActionRule {
+	return &AwsIAMPolicyAllowNotActionRule{}
+}
+
+// Name returns the rule name
+func (r *AwsIAMPolicyAllowNotActionRule) Name() string {
+	return "aws_iam_policy_allow_not_action"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsIAMPolicyAllowNotActionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsIAMPolicyAllowNotActionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsIAMPolicyAllowNotActionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each statement with NotAction has the "Allow" effect
+func (r *AwsIAMPolicyAllowNotActionRule) Check(runner tflint.Runner) error {
+	return walkIAMPolicyDocuments(runner, func(statement *iamPolicyStatement) error {
+		if statement.IsAllow() && len(statement.NotAction) > 0 {
+			return runner.EmitIssue(
+				r,
+				"The policy statement combines NotAction with Allow, which grants every action except the listed ones.",
+				statement.Range,
+			)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsIAMPolicyAllowNotAction(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "NotAction with Allow in JSON policy",
+			Content: `
+resource "aws_iam_user_policy" "policy" {
+  name = "test_policy"
+  user = "test_user"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "NotAction": "iam:*",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyAllowNotActionRule(),
+					Message: "The policy statement combines NotAction with Allow, which grants every action except the listed ones.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 12},
+						End:      hcl.Pos{Line: 16, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "NotAction with default effect in policy document",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    not_actions = ["iam:*"]
+    resources   = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyAllowNotActionRule(),
+					Message: "The policy statement combines NotAction with Allow, which grants every action except the listed ones.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "NotAction with Deny",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    effect      = "Deny"
+    not_actions = ["iam:*"]
+    resources   = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsIAMPolicyAllowNotActionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
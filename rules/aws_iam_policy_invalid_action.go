@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"github.com/terraform-linters/tflint-ruleset-aws/rules/iam"
+)
+
+// AwsIAMPolicyInvalidActionRule checks whether actions in policy statements exist in the IAM action catalog
+type AwsIAMPolicyInvalidActionRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsIAMPolicyInvalidActionRule returns new rule with default attributes
+func NewAwsIAMPolicyInvalidActionRule() *AwsIAMPolicyInvalidActionRule {
+	return &AwsIAMPolicyInvalidActionRule{}
+}
+
+// Name returns the rule name
+func (r *AwsIAMPolicyInvalidActionRule) Name() string {
+	return "aws_iam_policy_invalid_action"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsIAMPolicyInvalidActionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsIAMPolicyInvalidActionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsIAMPolicyInvalidActionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each action and wildcard pattern in Action/NotAction matches the catalog.
+// Actions of services that are not in the catalog are ignored.
+func (r *AwsIAMPolicyInvalidActionRule) Check(runner tflint.Runner) error {
+	return walkIAMPolicyDocuments(runner, func(statement *iamPolicyStatement) error {
+		actions := append(append(iamPolicyValues{}, statement.Action...), statement.NotAction...)
+
+		for _, action := range actions {
+			message := r.validate(action)
+			if message == "" {
+				continue
+			}
+			if err := runner.EmitIssue(r, message, statement.Range); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// validate returns the issue message for the passed action, or an empty string if it is valid
+func (r *AwsIAMPolicyInvalidActionRule) validate(action string) string {
+	if action == "*" {
+		return ""
+	}
+
+	prefix, name, found := strings.Cut(action, ":")
+	if !found || prefix == "" || name == "" {
+		return fmt.Sprintf(`"%s" is an invalid action. It must be in the format "service:Action".`, action)
+	}
+
+	service, ok := iam.LookupService(prefix)
+	if !ok {
+		return ""
+	}
+
+	if strings.ContainsAny(name, "*?") {
+		if len(service.MatchActions(name)) == 0 {
+			return fmt.Sprintf(`"%s" does not match any action of %s.`, action, service.Name)
+		}
+		return ""
+	}
+
+	if _, ok := service.LookupAction(name); ok {
+		return ""
+	}
+
+	if suggestion := service.SuggestAction(name); suggestion != "" {
+		return fmt.Sprintf(`"%s" is an invalid action. Did you mean "%s:%s"?`, action, service.Prefix, suggestion)
+	}
+	return fmt.Sprintf(`"%s" is an invalid action of %s.`, action, service.Name)
+}
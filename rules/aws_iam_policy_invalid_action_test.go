@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsIAMPolicyInvalidAction(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "typos in JSON policy",
+			Content: `
+resource "aws_iam_policy" "policy" {
+  name = "test_policy"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObjects", "ec2:DescribeInstance", "s3:ListBucket"],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyInvalidActionRule(),
+					Message: `"s3:GetObjects" is an invalid action. Did you mean "s3:GetObject"?`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 15, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsIAMPolicyInvalidActionRule(),
+					Message: `"ec2:DescribeInstance" is an invalid action. Did you mean "ec2:DescribeInstances"?`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 15, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcards and format in policy document",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    actions   = ["s3:Gett*", "s3:Get*", "SQS:sendmessage", "s3GetObject", "*"]
+    resources = ["*"]
+  }
+
+  statement {
+    effect      = "Deny"
+    not_actions = ["kms:Decrypt", "kms:FooBarBaz"]
+    resources   = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyInvalidActionRule(),
+					Message: `"s3:Gett*" does not match any action of Amazon S3.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+				{
+					Rule:    NewAwsIAMPolicyInvalidActionRule(),
+					Message: `"s3GetObject" is an invalid action. It must be in the format "service:Action".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+				{
+					Rule:    NewAwsIAMPolicyInvalidActionRule(),
+					Message: `"kms:FooBarBaz" is an invalid action of AWS Key Management Service.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 3},
+						End:      hcl.Pos{Line: 8, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "services not in the catalog",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    actions   = ["unknownservice:DoSomething", "iam:PassRole", "lambda:InvokeFunction"]
+    resources = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsIAMPolicyInvalidActionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
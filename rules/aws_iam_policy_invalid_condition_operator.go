@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"golang.org/x/exp/maps"
+)
+
+// AwsIAMPolicyInvalidConditionOperatorRule checks whether policy statements use unknown condition operators
+type AwsIAMPolicyInvalidConditionOperatorRule struct {
+	tflint.DefaultRule
+
+	operators map[string]bool
+}
+
+// NewAwsIAMPolicyInvalidConditionOperatorRule returns new rule with default attributes
+func NewAwsIAMPolicyInvalidConditionOperatorRule() *AwsIAMPolicyInvalidConditionOperatorRule {
+	return &AwsIAMPolicyInvalidConditionOperatorRule{
+		// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html
+		operators: map[string]bool{
+			"StringEquals":              true,
+			"StringNotEquals":           true,
+			"StringEqualsIgnoreCase":    true,
+			"StringNotEqualsIgnoreCase": true,
+			"StringLike":                true,
+			"StringNotLike":             true,
+			"NumericEquals":             true,
+			"NumericNotEquals":          true,
+			"NumericLessThan":           true,
+			"NumericLessThanEquals":     true,
+			"NumericGreaterThan":        true,
+			"NumericGreaterThanEquals":  true,
+			"DateEquals":                true,
+			"DateNotEquals":             true,
+			"DateLessThan":              true,
+			"DateLessThanEquals":        true,
+			"DateGreaterThan":           true,
+			"DateGreaterThanEquals":     true,
+			"Bool":                      true,
+			"BinaryEquals":              true,
+			"IpAddress":                 true,
+			"NotIpAddress":              true,
+			"ArnEquals":                 true,
+			"ArnLike":                   true,
+			"ArnNotEquals":              true,
+			"ArnNotLike":                true,
+			"Null":                      true,
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsIAMPolicyInvalidConditionOperatorRule) Name() string {
+	return "aws_iam_policy_invalid_condition_operator"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsIAMPolicyInvalidConditionOperatorRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsIAMPolicyInvalidConditionOperatorRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsIAMPolicyInvalidConditionOperatorRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each condition operator is known
+func (r *AwsIAMPolicyInvalidConditionOperatorRule) Check(runner tflint.Runner) error {
+	return walkIAMPolicyDocuments(runner, func(statement *iamPolicyStatement) error {
+		operators := maps.Keys(statement.Condition)
+		sort.Strings(operators)
+
+		for _, operator := range operators {
+			if r.validOperator(operator) {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf(`"%s" is an unknown condition operator.`, operator),
+				statement.Range,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// validOperator returns whether the operator is known, taking set operator prefixes and the IfExists suffix into account
+func (r *AwsIAMPolicyInvalidConditionOperatorRule) validOperator(operator string) bool {
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if strings.HasPrefix(operator, prefix) {
+			operator = strings.TrimPrefix(operator, prefix)
+			break
+		}
+	}
+
+	// The Null condition operator cannot be combined with IfExists
+	if operator != "NullIfExists" {
+		operator = strings.TrimSuffix(operator, "IfExists")
+	}
+
+	return r.operators[operator]
+}
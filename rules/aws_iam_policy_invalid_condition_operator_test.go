@@ -0,0 +1,146 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsIAMPolicyInvalidConditionOperator(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "unknown operators in JSON policy",
+			Content: `
+resource "aws_iam_policy" "policy" {
+  name = "test_policy"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*",
+      "Condition": {
+        "StringEqual": {
+          "aws:PrincipalOrgID": "o-123456"
+        },
+        "BoolIfExists": {
+          "aws:SecureTransport": true
+        },
+        "IPAddress": {
+          "aws:SourceIp": [
+            "10.0.0.0/8"
+          ]
+        }
+      }
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyInvalidConditionOperatorRule(),
+					Message: `"IPAddress" is an unknown condition operator.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 28, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsIAMPolicyInvalidConditionOperatorRule(),
+					Message: `"StringEqual" is an unknown condition operator.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 28, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown operator in policy document",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+
+    condition {
+      test     = "ForAnyValues:StringLike"
+      variable = "aws:TagKeys"
+      values   = ["team*"]
+    }
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyInvalidConditionOperatorRule(),
+					Message: `"ForAnyValues:StringLike" is an unknown condition operator.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid operators",
+			Content: `
+resource "aws_iam_policy" "policy" {
+  name = "test_policy"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*",
+      "Condition": {
+        "ForAllValues:StringEqualsIgnoreCase": {
+          "aws:TagKeys": [
+            "team"
+          ]
+        },
+        "NumericLessThanEqualsIfExists": {
+          "s3:max-keys": 10
+        },
+        "Null": {
+          "aws:TokenIssueTime": true
+        }
+      }
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsIAMPolicyInvalidConditionOperatorRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsIAMPolicyInvalidEffectRule checks whether the effect of policy statements is "Allow" or "Deny"
+type AwsIAMPolicyInvalidEffectRule struct {
+	tflint.DefaultRule
+
+	effects map[string]bool
+}
+
+// NewAwsIAMPolicyInvalidEffectRule returns new rule with default attributes
+func NewAwsIAMPolicyInvalidEffectRule() *AwsIAMPolicyInvalidEffectRule {
+	return &AwsIAMPolicyInvalidEffectRule{
+		effects: map[string]bool{
+			"Allow": true,
+			"Deny":  true,
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsIAMPolicyInvalidEffectRule) Name() string {
+	return "aws_iam_policy_invalid_effect"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsIAMPolicyInvalidEffectRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsIAMPolicyInvalidEffectRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsIAMPolicyInvalidEffectRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each statement has a valid effect
+func (r *AwsIAMPolicyInvalidEffectRule) Check(runner tflint.Runner) error {
+	return walkIAMPolicyDocuments(runner, func(statement *iamPolicyStatement) error {
+		if statement.UnknownEffect {
+			return nil
+		}
+
+		if statement.Effect == "" {
+			return runner.EmitIssue(
+				r,
+				`The policy statement has no effect. It must be "Allow" or "Deny".`,
+				statement.Range,
+			)
+		}
+
+		if !r.effects[statement.Effect] {
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf(`"%s" is an invalid effect. It must be "Allow" or "Deny".`, statement.Effect),
+				statement.Range,
+			)
+		}
+		return nil
+	})
+}
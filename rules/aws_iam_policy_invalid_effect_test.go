@@ -0,0 +1,157 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsIAMPolicyInvalidEffect(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "invalid effect in JSON policy",
+			Content: `
+resource "aws_iam_role_policy" "policy" {
+  name = "test_policy"
+  role = "test_role"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:Describe*",
+      "Effect": "allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyInvalidEffectRule(),
+					Message: `"allow" is an invalid effect. It must be "Allow" or "Deny".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 12},
+						End:      hcl.Pos{Line: 16, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing effect in single statement",
+			Content: `
+resource "aws_iam_user_policy" "policy" {
+  name = "test_policy"
+  user = "test_user"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Action": "ec2:Describe*",
+    "Resource": "*"
+  }
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyInvalidEffectRule(),
+					Message: `The policy statement has no effect. It must be "Allow" or "Deny".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 12},
+						End:      hcl.Pos{Line: 13, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid effect in policy document",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    effect    = "Permit"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyInvalidEffectRule(),
+					Message: `"Permit" is an invalid effect. It must be "Allow" or "Deny".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "effect cannot be evaluated",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    effect    = module.policy.effect
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "valid effects",
+			Content: `
+resource "aws_iam_group_policy" "policy" {
+  name  = "test_policy"
+  group = "test_group"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Action": "ec2:Describe*",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_iam_policy_document" "policy" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsIAMPolicyInvalidEffectRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := newUnknownModuleRunner(t, map[string]string{"resource.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -1,25 +1,15 @@
 package rules
 
 import (
-	"encoding/json"
 	"fmt"
 	"regexp"
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/terraform-linters/tflint-ruleset-aws/project"
 )
 
-type AwsIAMPolicySidInvalidCharactersPolicyStatement struct {
-	Sid string `json:"Sid"`
-}
-type AwsIAMPolicySidInvalidCharactersPolicy struct {
-	Statement []AwsIAMPolicySidInvalidCharactersPolicyStatement `json:"Statement"`
-}
-type AwsIAMPolicySidInvalidCharactersPolicyWithSingleStatement struct {
-	Statement AwsIAMPolicySidInvalidCharactersPolicyStatement `json:"Statement"`
-}
-
 // AwsIAMPolicySidInvalidCharactersRule checks for invalid characters in SID
 type AwsIAMPolicySidInvalidCharactersRule struct {
 	tflint.DefaultRule
@@ -74,22 +64,13 @@ func (r *AwsIAMPolicySidInvalidCharactersRule) Check(runner tflint.Runner) error
 		}
 
 		err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
-			var statements []AwsIAMPolicySidInvalidCharactersPolicyStatement
-
-			policy := AwsIAMPolicySidInvalidCharactersPolicy{}
-			if err := json.Unmarshal([]byte(val), &policy); err != nil {
-				// If the Statement clause includes only one value, you can omit the brackets, so try unmarshal to the struct accordingly.
-				// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_grammar.html
-				policy := AwsIAMPolicySidInvalidCharactersPolicyWithSingleStatement{}
-				if err := json.Unmarshal([]byte(val), &policy); err != nil {
-					return err
-				}
-				statements = []AwsIAMPolicySidInvalidCharactersPolicyStatement{policy.Statement}
-			} else {
-				statements = policy.Statement
+			policy, err := parseIAMPolicyDocument(val)
+			if err != nil {
+				logger.Debug("Failed to parse the policy of %s.%s: %s", resource.Labels[0], resource.Labels[1], err)
+				return nil
 			}
 
-			for _, statement := range statements {
+			for _, statement := range policy.Statement {
 				if statement.Sid == "" {
 					continue
 				}
@@ -135,6 +135,22 @@ resource "aws_iam_policy" "policy" {
 }
 EOF
 }
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Unparsable policy",
+			Content: `
+resource "aws_iam_policy" "policy" {
+	name = "test_policy"
+	role = "test_role"
+	policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": "invalid"
+}
+EOF
+}
 `,
 			Expected: helper.Issues{},
 		},
@@ -1,27 +1,26 @@
 package rules
 
 import (
-	"fmt"
-	"regexp"
-
-	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/terraform-linters/tflint-ruleset-aws/project"
 )
 
-// AwsIAMPolicyTooLongPolicyRule checks that the policy length is less than 6,144 characters
+// AwsIAMPolicyTooLongPolicyRule checks that the policy length is less than 6,144 characters.
+// This rule is deprecated in favor of aws_policy_too_long, which also checks the limit.
 type AwsIAMPolicyTooLongPolicyRule struct {
 	tflint.DefaultRule
 
-	resourceType  string
-	attributeName string
+	limit policyLengthLimit
 }
 
 // NewAwsIAMPolicyTooLongPolicyRule returns new rule with default attributes
 func NewAwsIAMPolicyTooLongPolicyRule() *AwsIAMPolicyTooLongPolicyRule {
 	return &AwsIAMPolicyTooLongPolicyRule{
-		resourceType:  "aws_iam_policy",
-		attributeName: "policy",
+		limit: policyLengthLimit{
+			resourceType:  "aws_iam_policy",
+			attributeName: "policy",
+			limit:         6144,
+		},
 	}
 }
 
@@ -32,7 +31,7 @@ func (r *AwsIAMPolicyTooLongPolicyRule) Name() string {
 
 // Enabled returns whether the rule is enabled by default
 func (r *AwsIAMPolicyTooLongPolicyRule) Enabled() bool {
-	return true
+	return false
 }
 
 // Severity returns the rule severity
@@ -47,36 +46,9 @@ func (r *AwsIAMPolicyTooLongPolicyRule) Link() string {
 
 // Check checks the length of the policy
 func (r *AwsIAMPolicyTooLongPolicyRule) Check(runner tflint.Runner) error {
-	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
-	}, nil)
+	checker, err := newPolicyLengthChecker(runner)
 	if err != nil {
 		return err
 	}
-
-	for _, resource := range resources.Blocks {
-		attribute, exists := resource.Body.Attributes[r.attributeName]
-		if !exists {
-			continue
-		}
-
-		err := runner.EvaluateExpr(attribute.Expr, func(policy string) error {
-			whitespaceRegex := regexp.MustCompile(`\s+`)
-			policy = whitespaceRegex.ReplaceAllString(policy, "")
-
-			if len(policy) > 6144 {
-				runner.EmitIssue(
-					r,
-					fmt.Sprintf("The policy length is %d characters and is limited to 6144 characters.", len(policy)),
-					attribute.Expr.Range(),
-				)
-			}
-			return nil
-		}, nil)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return checker.check(r, r.limit)
 }
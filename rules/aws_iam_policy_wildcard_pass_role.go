@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsIAMPolicyWildcardPassRoleRule checks whether policy statements allow iam:PassRole on all resources
+type AwsIAMPolicyWildcardPassRoleRule struct {
+	tflint.DefaultRule
+
+	action string
+}
+
+// NewAwsIAMPolicyWildcardPassRoleRule returns new rule with default attributes
+func NewAwsIAMPolicyWildcardPassRoleRule() *AwsIAMPolicyWildcardPassRoleRule {
+	return &AwsIAMPolicyWildcardPassRoleRule{
+		action: "iam:PassRole",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsIAMPolicyWildcardPassRoleRule) Name() string {
+	return "aws_iam_policy_wildcard_pass_role"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsIAMPolicyWildcardPassRoleRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsIAMPolicyWildcardPassRoleRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsIAMPolicyWildcardPassRoleRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each statement allows iam:PassRole (including wildcard actions and NotAction) on "*"
+func (r *AwsIAMPolicyWildcardPassRoleRule) Check(runner tflint.Runner) error {
+	return walkIAMPolicyDocuments(runner, func(statement *iamPolicyStatement) error {
+		if !statement.IsAllow() || !statement.Resource.Contains("*") {
+			return nil
+		}
+
+		for _, action := range statement.Action {
+			if iamActionMatch(action, r.action) {
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The policy statement allows %s on all resources ("*") through "%s".`, r.action, action),
+					statement.Range,
+				)
+			}
+		}
+
+		// NotAction allows all actions that it does not match
+		if len(statement.Action) > 0 || len(statement.NotAction) == 0 {
+			return nil
+		}
+		for _, action := range statement.NotAction {
+			if iamActionMatch(action, r.action) {
+				return nil
+			}
+		}
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf(`The policy statement allows %s on all resources ("*") through NotAction.`, r.action),
+			statement.Range,
+		)
+	})
+}
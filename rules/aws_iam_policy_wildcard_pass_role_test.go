@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsIAMPolicyWildcardPassRole(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "iam:PassRole on wildcard",
+			Content: `
+resource "aws_iam_policy" "policy" {
+  name = "test_policy"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:RunInstances",
+        "iam:PassRole"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyWildcardPassRoleRule(),
+					Message: `The policy statement allows iam:PassRole on all resources ("*") through "iam:PassRole".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 18, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcard action in policy document",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    actions   = ["IAM:Pass*"]
+    resources = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyWildcardPassRoleRule(),
+					Message: `The policy statement allows iam:PassRole on all resources ("*") through "IAM:Pass*".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "NotAction without iam:PassRole",
+			Content: `
+resource "aws_iam_policy" "policy" {
+  name = "test_policy"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "NotAction": "iam:Create*",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMPolicyWildcardPassRoleRule(),
+					Message: `The policy statement allows iam:PassRole on all resources ("*") through NotAction.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 15, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "NotAction with iam:PassRole",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    not_actions = ["iam:*"]
+    resources   = ["*"]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "scoped role",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    actions   = ["iam:PassRole"]
+    resources = ["arn:aws:iam::123456789012:role/ecs-task"]
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsIAMPolicyWildcardPassRoleRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"golang.org/x/exp/maps"
+)
+
+// AwsIAMRoleInsecureTrustPolicyRule checks whether the trust policy of IAM roles allows unintended principals
+type AwsIAMRoleInsecureTrustPolicyRule struct {
+	tflint.DefaultRule
+
+	resourceType    string
+	attributeName   string
+	accountIDRegexp *regexp.Regexp
+	oidcProvider    string
+}
+
+type awsIAMRoleInsecureTrustPolicyRuleConfig struct {
+	TrustedAccounts []string `hclext:"trusted_accounts,optional"`
+}
+
+// NewAwsIAMRoleInsecureTrustPolicyRule returns new rule with default attributes
+func NewAwsIAMRoleInsecureTrustPolicyRule() *AwsIAMRoleInsecureTrustPolicyRule {
+	return &AwsIAMRoleInsecureTrustPolicyRule{
+		resourceType:  "aws_iam_role",
+		attributeName: "assume_role_policy",
+		// Matches both an account ID and an IAM/STS ARN such as arn:aws:iam::123456789012:root
+		accountIDRegexp: regexp.MustCompile(`^(?:arn:[^:]+:(?:iam|sts)::)?(\d{12})(?::.*)?$`),
+		oidcProvider:    "token.actions.githubusercontent.com",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsIAMRoleInsecureTrustPolicyRule) Name() string {
+	return "aws_iam_role_insecure_trust_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsIAMRoleInsecureTrustPolicyRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsIAMRoleInsecureTrustPolicyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsIAMRoleInsecureTrustPolicyRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks the principals and conditions of each statement in the trust policy
+func (r *AwsIAMRoleInsecureTrustPolicyRule) Check(runner tflint.Runner) error {
+	config := awsIAMRoleInsecureTrustPolicyRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	trusted := map[string]bool{}
+	for _, account := range config.TrustedAccounts {
+		trusted[account] = true
+	}
+
+	documents, err := renderIAMPolicyDocumentDataSources(runner)
+	if err != nil {
+		return err
+	}
+
+	return walkIAMPolicyAttribute(runner, r.resourceType, r.attributeName, documents, func(statement *iamPolicyStatement) error {
+		if !statement.IsAllow() {
+			return nil
+		}
+
+		if !statement.HasCondition() && (statement.Principal["*"].Contains("*") || statement.Principal["AWS"].Contains("*")) {
+			if err := runner.EmitIssue(
+				r,
+				"The trust policy allows any principal (\"*\") to assume the role without a condition.",
+				statement.Range,
+			); err != nil {
+				return err
+			}
+		}
+
+		if len(trusted) > 0 {
+			untrusted := map[string]bool{}
+			for _, principal := range statement.Principal["AWS"] {
+				match := r.accountIDRegexp.FindStringSubmatch(principal)
+				if match == nil || trusted[match[1]] {
+					continue
+				}
+				untrusted[match[1]] = true
+			}
+
+			accounts := maps.Keys(untrusted)
+			sort.Strings(accounts)
+			for _, account := range accounts {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The trust policy allows the untrusted account "%s" to assume the role.`, account),
+					statement.Range,
+				); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, federated := range statement.Principal["Federated"] {
+			if !strings.HasSuffix(federated, r.oidcProvider) {
+				continue
+			}
+			tested, restricted := r.conditionKeyRestricted(statement, r.oidcProvider+":sub")
+			var message string
+			switch {
+			case !tested:
+				message = fmt.Sprintf(`The trust policy allows %s without a condition on "%s:sub".`, r.oidcProvider, r.oidcProvider)
+			case !restricted:
+				message = fmt.Sprintf(`The trust policy allows %s with only wildcards in the condition on "%s:sub".`, r.oidcProvider, r.oidcProvider)
+			default:
+				continue
+			}
+			if err := runner.EmitIssue(r, message, statement.Range); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// conditionKeyRestricted returns whether any condition of the statement tests the passed key,
+// and whether any of the tested values is not wildcard-only, such as "*" or "repo:*"
+func (r *AwsIAMRoleInsecureTrustPolicyRule) conditionKeyRestricted(statement *iamPolicyStatement, key string) (tested bool, restricted bool) {
+	for _, condition := range statement.Condition {
+		for conditionKey, values := range condition {
+			// Condition keys are case-insensitive
+			if !strings.EqualFold(conditionKey, key) {
+				continue
+			}
+			tested = true
+
+			for _, value := range values {
+				if !isWildcardOnlySubject(value) {
+					restricted = true
+				}
+			}
+		}
+	}
+	return tested, restricted
+}
+
+// isWildcardOnlySubject returns whether the subject matches any value of its type.
+// Each segment except the leading type (e.g. "repo") consists only of wildcards.
+func isWildcardOnlySubject(subject string) bool {
+	segments := strings.Split(subject, ":")
+	if len(segments) > 1 {
+		segments = segments[1:]
+	}
+	for _, segment := range segments {
+		if strings.Trim(segment, "*?") != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,288 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsIAMRoleInsecureTrustPolicy(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "wildcard principal without condition",
+			Content: `
+resource "aws_iam_role" "role" {
+  name               = "test_role"
+  assume_role_policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "*"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+`,
+			Config: `
+rule "aws_iam_role_insecure_trust_policy" {
+  enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMRoleInsecureTrustPolicyRule(),
+					Message: `The trust policy allows any principal ("*") to assume the role without a condition.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 15, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcard principal with condition",
+			Content: `
+resource "aws_iam_role" "role" {
+  name               = "test_role"
+  assume_role_policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "sts:AssumeRole",
+    "Condition": {"StringEquals": {"aws:PrincipalOrgID": "o-123456"}}
+  }
+}
+EOF
+}
+`,
+			Config: `
+rule "aws_iam_role_insecure_trust_policy" {
+  enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "untrusted accounts",
+			Content: `
+resource "aws_iam_role" "role" {
+  name               = "test_role"
+  assume_role_policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": [
+          "arn:aws:iam::111111111111:root",
+          "arn:aws:iam::222222222222:role/deploy",
+          "333333333333"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+`,
+			Config: `
+rule "aws_iam_role_insecure_trust_policy" {
+  enabled          = true
+  trusted_accounts = ["111111111111"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMRoleInsecureTrustPolicyRule(),
+					Message: `The trust policy allows the untrusted account "222222222222" to assume the role.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 21, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsIAMRoleInsecureTrustPolicyRule(),
+					Message: `The trust policy allows the untrusted account "333333333333" to assume the role.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 21, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "GitHub OIDC without sub condition",
+			Content: `
+resource "aws_iam_role" "role" {
+  name               = "test_role"
+  assume_role_policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Federated": "arn:aws:iam::111111111111:oidc-provider/token.actions.githubusercontent.com"
+      },
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {
+        "StringEquals": {"token.actions.githubusercontent.com:aud": "sts.amazonaws.com"}
+      }
+    }
+  ]
+}
+EOF
+}
+`,
+			Config: `
+rule "aws_iam_role_insecure_trust_policy" {
+  enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMRoleInsecureTrustPolicyRule(),
+					Message: `The trust policy allows token.actions.githubusercontent.com without a condition on "token.actions.githubusercontent.com:sub".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 20, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "GitHub OIDC with wildcard-only sub condition",
+			Content: `
+resource "aws_iam_role" "role" {
+  name               = "test_role"
+  assume_role_policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Federated": "arn:aws:iam::111111111111:oidc-provider/token.actions.githubusercontent.com"
+      },
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {
+        "StringLike": {"token.actions.githubusercontent.com:sub": ["*", "repo:*"]}
+      }
+    }
+  ]
+}
+EOF
+}
+`,
+			Config: `
+rule "aws_iam_role_insecure_trust_policy" {
+  enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMRoleInsecureTrustPolicyRule(),
+					Message: `The trust policy allows token.actions.githubusercontent.com with only wildcards in the condition on "token.actions.githubusercontent.com:sub".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 20, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "wildcard principal in policy document",
+			Content: `
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+  }
+}
+
+resource "aws_iam_role" "role" {
+  name               = "test_role"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+`,
+			Config: `
+rule "aws_iam_role_insecure_trust_policy" {
+  enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsIAMRoleInsecureTrustPolicyRule(),
+					Message: "The trust policy allows any principal (\"*\") to assume the role without a condition.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 24},
+						End:      hcl.Pos{Line: 15, Column: 69},
+					},
+				},
+			},
+		},
+		{
+			Name: "GitHub OIDC with sub condition",
+			Content: `
+resource "aws_iam_role" "role" {
+  name               = "test_role"
+  assume_role_policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Federated": "arn:aws:iam::111111111111:oidc-provider/token.actions.githubusercontent.com"
+      },
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {
+        "StringEquals": {"token.actions.githubusercontent.com:aud": "sts.amazonaws.com"},
+        "StringLike": {"token.actions.githubusercontent.com:sub": "repo:octo-org/octo-repo:*"}
+      }
+    }
+  ]
+}
+EOF
+}
+`,
+			Config: `
+rule "aws_iam_role_insecure_trust_policy" {
+  enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsIAMRoleInsecureTrustPolicyRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
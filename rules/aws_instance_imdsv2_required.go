@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsInstanceIMDSv2RequiredRule checks whether instances require IMDSv2 session tokens
+type AwsInstanceIMDSv2RequiredRule struct {
+	tflint.DefaultRule
+
+	resourceTypes []string
+	blockName     string
+}
+
+type awsInstanceIMDSv2RequiredRuleConfig struct {
+	MaxHTTPPutResponseHopLimit int `hclext:"max_http_put_response_hop_limit,optional"`
+}
+
+// NewAwsInstanceIMDSv2RequiredRule returns new rule with default attributes
+func NewAwsInstanceIMDSv2RequiredRule() *AwsInstanceIMDSv2RequiredRule {
+	return &AwsInstanceIMDSv2RequiredRule{
+		resourceTypes: []string{"aws_instance", "aws_launch_template", "aws_launch_configuration"},
+		blockName:     "metadata_options",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsInstanceIMDSv2RequiredRule) Name() string {
+	return "aws_instance_imdsv2_required"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsInstanceIMDSv2RequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsInstanceIMDSv2RequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsInstanceIMDSv2RequiredRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether `metadata_options` sets `http_tokens = "required"` and the hop limit does not exceed the ceiling.
+// Resources that disable the metadata service with `http_endpoint = "disabled"` are ignored.
+// Instances without `metadata_options` that are launched from a `launch_template` are ignored, as the launch template is checked instead.
+func (r *AwsInstanceIMDSv2RequiredRule) Check(runner tflint.Runner) error {
+	config := awsInstanceIMDSv2RequiredRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	for _, resourceType := range r.resourceTypes {
+		schema := &hclext.BodySchema{
+			Blocks: []hclext.BlockSchema{
+				{
+					Type: r.blockName,
+					Body: &hclext.BodySchema{
+						Attributes: []hclext.AttributeSchema{
+							{Name: "http_endpoint"},
+							{Name: "http_tokens"},
+							{Name: "http_put_response_hop_limit"},
+						},
+					},
+				},
+			},
+		}
+		// Only aws_instance can take metadata options from a launch template
+		if resourceType == "aws_instance" {
+			schema.Blocks = append(schema.Blocks, hclext.BlockSchema{Type: "launch_template", Body: &hclext.BodySchema{}})
+		}
+
+		resources, err := runner.GetResourceContent(resourceType, schema, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			optionsBlocks := resource.Body.Blocks.OfType(r.blockName)
+			if len(optionsBlocks) == 0 {
+				if len(resource.Body.Blocks.OfType("launch_template")) > 0 {
+					continue
+				}
+
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` block should be declared with `http_tokens = \"required\"`", r.blockName),
+					resource.DefRange,
+				); err != nil {
+					return err
+				}
+				continue
+			}
+
+			for _, options := range optionsBlocks {
+				if err := r.checkMetadataOptions(runner, options, config); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkMetadataOptions checks http_tokens and http_put_response_hop_limit in a metadata_options block
+func (r *AwsInstanceIMDSv2RequiredRule) checkMetadataOptions(runner tflint.Runner, options *hclext.Block, config awsInstanceIMDSv2RequiredRuleConfig) error {
+	if attribute, exists := options.Body.Attributes["http_endpoint"]; exists {
+		disabled := false
+		if err := runner.EvaluateExpr(attribute.Expr, func(endpoint string) error {
+			disabled = endpoint == "disabled"
+			return nil
+		}, nil); err != nil {
+			return err
+		}
+		if disabled {
+			return nil
+		}
+	}
+
+	attribute, exists := options.Body.Attributes["http_tokens"]
+	if !exists {
+		if err := runner.EmitIssue(r, "`http_tokens` should be set to \"required\"", options.DefRange); err != nil {
+			return err
+		}
+	} else {
+		err := runner.EvaluateExpr(attribute.Expr, func(tokens string) error {
+			if tokens == "required" {
+				return nil
+			}
+			return runner.EmitIssue(r, fmt.Sprintf("`http_tokens` is \"%s\", but should be \"required\"", tokens), attribute.Expr.Range())
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if config.MaxHTTPPutResponseHopLimit <= 0 {
+		return nil
+	}
+	attribute, exists = options.Body.Attributes["http_put_response_hop_limit"]
+	if !exists {
+		return nil
+	}
+	return runner.EvaluateExpr(attribute.Expr, func(hopLimit int) error {
+		if hopLimit <= config.MaxHTTPPutResponseHopLimit {
+			return nil
+		}
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("`http_put_response_hop_limit` is %d, but should be %d or less", hopLimit, config.MaxHTTPPutResponseHopLimit),
+			attribute.Expr.Range(),
+		)
+	}, nil)
+}
@@ -0,0 +1,140 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsInstanceIMDSv2Required(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "metadata options are not declared",
+			Content: `
+resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsInstanceIMDSv2RequiredRule(),
+					Message: "`metadata_options` block should be declared with `http_tokens = \"required\"`",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "tokens are optional",
+			Content: `
+resource "aws_launch_template" "web" {
+  metadata_options {
+    http_tokens = "optional"
+  }
+}
+
+resource "aws_launch_configuration" "web" {
+  metadata_options {
+    http_endpoint = "enabled"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsInstanceIMDSv2RequiredRule(),
+					Message: "`http_tokens` is \"optional\", but should be \"required\"",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 19},
+						End:      hcl.Pos{Line: 4, Column: 29},
+					},
+				},
+				{
+					Rule:    NewAwsInstanceIMDSv2RequiredRule(),
+					Message: "`http_tokens` should be set to \"required\"",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 3},
+						End:      hcl.Pos{Line: 9, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "hop limit exceeds the ceiling",
+			Content: `
+resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+
+  metadata_options {
+    http_tokens                 = "required"
+    http_put_response_hop_limit = 3
+  }
+}
+`,
+			Config: `
+rule "aws_instance_imdsv2_required" {
+  enabled                         = true
+  max_http_put_response_hop_limit = 2
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsInstanceIMDSv2RequiredRule(),
+					Message: "`http_put_response_hop_limit` is 3, but should be 2 or less",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 35},
+						End:      hcl.Pos{Line: 7, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "IMDSv2 is required",
+			Content: `
+resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+
+  metadata_options {
+    http_tokens                 = "required"
+    http_put_response_hop_limit = 3
+  }
+}
+
+resource "aws_launch_template" "disabled" {
+  metadata_options {
+    http_endpoint = "disabled"
+  }
+}
+
+resource "aws_instance" "from_template" {
+  launch_template {
+    id = aws_launch_template.disabled.id
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsInstanceIMDSv2RequiredRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
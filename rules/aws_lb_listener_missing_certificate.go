@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsLbListenerMissingCertificateRule checks whether HTTPS and TLS listeners declare certificate_arn
+type AwsLbListenerMissingCertificateRule struct {
+	tflint.DefaultRule
+
+	attributeName string
+	// secureProtocols are protocols that require a server certificate
+	secureProtocols []string
+}
+
+// NewAwsLbListenerMissingCertificateRule returns new rule with default attributes
+func NewAwsLbListenerMissingCertificateRule() *AwsLbListenerMissingCertificateRule {
+	return &AwsLbListenerMissingCertificateRule{
+		attributeName:   "certificate_arn",
+		secureProtocols: []string{"HTTPS", "TLS"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsLbListenerMissingCertificateRule) Name() string {
+	return "aws_lb_listener_missing_certificate"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsLbListenerMissingCertificateRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsLbListenerMissingCertificateRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsLbListenerMissingCertificateRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether listeners with HTTPS or TLS protocol declare certificate_arn
+func (r *AwsLbListenerMissingCertificateRule) Check(runner tflint.Runner) error {
+	for _, resourceType := range lbListenerResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "protocol"},
+				{Name: r.attributeName},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			protocol, err := lbEvaluateString(runner, resource.Body, "protocol")
+			if err != nil {
+				return err
+			}
+			protocol = strings.ToUpper(protocol)
+			if !stringInSlice(protocol, r.secureProtocols) {
+				continue
+			}
+
+			set, err := isAttributeSet(runner, resource.Body, r.attributeName)
+			if err != nil {
+				return err
+			}
+			if set {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s is required for %s listeners", r.attributeName, protocol),
+				resource.Body.Attributes["protocol"].Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
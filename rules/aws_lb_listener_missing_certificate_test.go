@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsLbListenerMissingCertificate(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "HTTPS listener without certificate",
+			Content: `
+resource "aws_lb_listener" "https" {
+  load_balancer_arn = aws_lb.main.arn
+  port              = 443
+  protocol          = "HTTPS"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbListenerMissingCertificateRule(),
+					Message: "certificate_arn is required for HTTPS listeners",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 23},
+						End:      hcl.Pos{Line: 5, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "TLS listener of aws_alb_listener without certificate",
+			Content: `
+resource "aws_alb_listener" "tls" {
+  load_balancer_arn = aws_lb.main.arn
+  port              = 443
+  protocol          = "tls"
+  certificate_arn   = null
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbListenerMissingCertificateRule(),
+					Message: "certificate_arn is required for TLS listeners",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 23},
+						End:      hcl.Pos{Line: 5, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "listeners with certificate and HTTP listeners",
+			Content: `
+resource "aws_lb_listener" "https" {
+  load_balancer_arn = aws_lb.main.arn
+  port              = 443
+  protocol          = "HTTPS"
+  certificate_arn   = "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"
+}
+
+resource "aws_lb_listener" "http" {
+  load_balancer_arn = aws_lb.main.arn
+  port              = 80
+  protocol          = "HTTP"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsLbListenerMissingCertificateRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,202 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsLbListenerProtocolMismatchRule checks whether protocols of listeners and target groups are supported by the load balancers
+type AwsLbListenerProtocolMismatchRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsLbListenerProtocolMismatchRule returns new rule with default attributes
+func NewAwsLbListenerProtocolMismatchRule() *AwsLbListenerProtocolMismatchRule {
+	return &AwsLbListenerProtocolMismatchRule{}
+}
+
+// Name returns the rule name
+func (r *AwsLbListenerProtocolMismatchRule) Name() string {
+	return "aws_lb_listener_protocol_mismatch"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsLbListenerProtocolMismatchRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsLbListenerProtocolMismatchRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsLbListenerProtocolMismatchRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+var lbActionSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{{Name: "target_group_arn"}},
+	Blocks: []hclext.BlockSchema{
+		{
+			Type: "forward",
+			Body: &hclext.BodySchema{
+				Blocks: []hclext.BlockSchema{
+					{
+						Type: "target_group",
+						Body: &hclext.BodySchema{
+							Attributes: []hclext.AttributeSchema{{Name: "arn"}},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// Check checks the protocols of listeners, and the protocols of target groups that listeners and listener rules forward to.
+// Load balancers, listeners and target groups are resolved by references, and unknown values are ignored.
+func (r *AwsLbListenerProtocolMismatchRule) Check(runner tflint.Runner) error {
+	loadBalancers, err := lbTypes(runner)
+	if err != nil {
+		return err
+	}
+	targetGroups, err := lbTargetGroupProtocols(runner)
+	if err != nil {
+		return err
+	}
+
+	// listeners maps listener addresses to the addresses of their load balancers
+	listeners := map[string]string{}
+
+	for _, resourceType := range lbListenerResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "load_balancer_arn"},
+				{Name: "protocol"},
+			},
+			Blocks: []hclext.BlockSchema{
+				{Type: "default_action", Body: lbActionSchema},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attribute, exists := resource.Body.Attributes["load_balancer_arn"]
+			if !exists {
+				continue
+			}
+			loadBalancer, ok := lbReference(attribute.Expr, lbResourceTypes, "arn", "id")
+			if !ok || loadBalancers[loadBalancer] == "" {
+				continue
+			}
+			listeners[fmt.Sprintf("%s.%s", resourceType, resource.Labels[1])] = loadBalancer
+
+			if err := r.checkListenerProtocol(runner, resource, loadBalancer, loadBalancers[loadBalancer]); err != nil {
+				return err
+			}
+			if err := r.checkActions(runner, resource.Body.Blocks, targetGroups, loadBalancer, loadBalancers[loadBalancer]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, resourceType := range lbListenerRuleResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "listener_arn"}},
+			Blocks: []hclext.BlockSchema{
+				{Type: "action", Body: lbActionSchema},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attribute, exists := resource.Body.Attributes["listener_arn"]
+			if !exists {
+				continue
+			}
+			listener, ok := lbReference(attribute.Expr, lbListenerResourceTypes, "arn", "id")
+			if !ok {
+				continue
+			}
+			loadBalancer, exists := listeners[listener]
+			if !exists {
+				continue
+			}
+
+			if err := r.checkActions(runner, resource.Body.Blocks, targetGroups, loadBalancer, loadBalancers[loadBalancer]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *AwsLbListenerProtocolMismatchRule) checkListenerProtocol(runner tflint.Runner, listener *hclext.Block, loadBalancer, loadBalancerType string) error {
+	protocol, err := lbEvaluateString(runner, listener.Body, "protocol")
+	if err != nil || protocol == "" {
+		return err
+	}
+
+	protocols := lbProtocols[loadBalancerType]
+	if protocols == nil || stringInSlice(strings.ToUpper(protocol), protocols) {
+		return nil
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf(`"%s" is not a valid protocol for the %s load balancer %s. It must be one of %s`, protocol, loadBalancerType, loadBalancer, strings.Join(protocols, ", ")),
+		listener.Body.Attributes["protocol"].Expr.Range(),
+	)
+}
+
+func (r *AwsLbListenerProtocolMismatchRule) checkActions(runner tflint.Runner, actions hclext.Blocks, targetGroups map[string]string, loadBalancer, loadBalancerType string) error {
+	protocols := lbProtocols[loadBalancerType]
+	if protocols == nil {
+		return nil
+	}
+
+	attributes := []*hclext.Attribute{}
+	for _, action := range actions {
+		if attribute, exists := action.Body.Attributes["target_group_arn"]; exists {
+			attributes = append(attributes, attribute)
+		}
+		for _, forward := range action.Body.Blocks {
+			for _, targetGroup := range forward.Body.Blocks {
+				if attribute, exists := targetGroup.Body.Attributes["arn"]; exists {
+					attributes = append(attributes, attribute)
+				}
+			}
+		}
+	}
+
+	for _, attribute := range attributes {
+		targetGroup, ok := lbReference(attribute.Expr, lbTargetGroupResourceTypes, "arn", "id")
+		if !ok {
+			continue
+		}
+		protocol, exists := targetGroups[targetGroup]
+		if !exists || stringInSlice(protocol, protocols) {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf(`The target group %s uses "%s", which is not supported by the %s load balancer %s`, targetGroup, protocol, loadBalancerType, loadBalancer),
+			attribute.Expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
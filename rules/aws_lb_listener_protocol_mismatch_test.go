@@ -0,0 +1,156 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsLbListenerProtocolMismatch(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "NLB protocol on ALB listener",
+			Content: `
+resource "aws_lb" "main" {
+  name = "main"
+}
+
+resource "aws_lb_listener" "tcp" {
+  load_balancer_arn = aws_lb.main.arn
+  port              = 443
+  protocol          = "TCP"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbListenerProtocolMismatchRule(),
+					Message: `"TCP" is not a valid protocol for the application load balancer aws_lb.main. It must be one of HTTP, HTTPS`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 23},
+						End:      hcl.Pos{Line: 9, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "ALB protocol on NLB listener with aliases",
+			Content: `
+resource "aws_alb" "main" {
+  name               = "main"
+  load_balancer_type = "network"
+}
+
+resource "aws_alb_listener" "http" {
+  load_balancer_arn = aws_alb.main.id
+  port              = 80
+  protocol          = "HTTP"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbListenerProtocolMismatchRule(),
+					Message: `"HTTP" is not a valid protocol for the network load balancer aws_alb.main. It must be one of TCP, TLS, UDP, TCP_UDP`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 23},
+						End:      hcl.Pos{Line: 10, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "target groups with mismatched protocols",
+			Content: `
+resource "aws_lb" "main" {
+  name = "main"
+}
+
+resource "aws_lb_target_group" "http" {
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = "vpc-1234abcd"
+}
+
+resource "aws_lb_target_group" "tcp" {
+  port     = 80
+  protocol = "TCP"
+  vpc_id   = "vpc-1234abcd"
+}
+
+resource "aws_lb_listener" "http" {
+  load_balancer_arn = aws_lb.main.arn
+  port              = 80
+  protocol          = "HTTP"
+
+  default_action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.tcp.arn
+  }
+}
+
+resource "aws_lb_listener_rule" "api" {
+  listener_arn = aws_lb_listener.http.arn
+
+  action {
+    type = "forward"
+
+    forward {
+      target_group {
+        arn = aws_lb_target_group.http.arn
+      }
+
+      target_group {
+        arn = aws_lb_target_group.tcp.arn
+      }
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbListenerProtocolMismatchRule(),
+					Message: `The target group aws_lb_target_group.tcp uses "TCP", which is not supported by the application load balancer aws_lb.main`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 25, Column: 24},
+						End:      hcl.Pos{Line: 25, Column: 51},
+					},
+				},
+				{
+					Rule:    NewAwsLbListenerProtocolMismatchRule(),
+					Message: `The target group aws_lb_target_group.tcp uses "TCP", which is not supported by the application load balancer aws_lb.main`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 41, Column: 15},
+						End:      hcl.Pos{Line: 41, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown load balancer",
+			Content: `
+resource "aws_lb_listener" "tcp" {
+  load_balancer_arn = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/main/1234567890abcdef"
+  port              = 443
+  protocol          = "TCP"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsLbListenerProtocolMismatchRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsLbListenerRuleDuplicatePriorityRule checks whether listener rules with the same priority are declared for the same listener
+type AwsLbListenerRuleDuplicatePriorityRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsLbListenerRuleDuplicatePriorityRule returns new rule with default attributes
+func NewAwsLbListenerRuleDuplicatePriorityRule() *AwsLbListenerRuleDuplicatePriorityRule {
+	return &AwsLbListenerRuleDuplicatePriorityRule{}
+}
+
+// Name returns the rule name
+func (r *AwsLbListenerRuleDuplicatePriorityRule) Name() string {
+	return "aws_lb_listener_rule_duplicate_priority"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsLbListenerRuleDuplicatePriorityRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsLbListenerRuleDuplicatePriorityRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsLbListenerRuleDuplicatePriorityRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each priority is unique in the listener.
+// Listeners are identified by resource addresses or literal listener ARNs, and rules without known priorities are ignored.
+func (r *AwsLbListenerRuleDuplicatePriorityRule) Check(runner tflint.Runner) error {
+	// declared maps priority keys to the first listener rule that declares them
+	declared := map[string]string{}
+
+	for _, resourceType := range lbListenerRuleResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "listener_arn"},
+				{Name: "priority"},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			listenerAttr, exists := resource.Body.Attributes["listener_arn"]
+			if !exists {
+				continue
+			}
+			priorityAttr, exists := resource.Body.Attributes["priority"]
+			if !exists {
+				continue
+			}
+
+			listener, ok := lbReference(listenerAttr.Expr, lbListenerResourceTypes, "arn", "id")
+			if !ok {
+				if listener, err = lbEvaluateString(runner, resource.Body, "listener_arn"); err != nil {
+					return err
+				}
+			}
+			if listener == "" {
+				continue
+			}
+
+			address := fmt.Sprintf("%s.%s", resourceType, resource.Labels[1])
+			err := runner.EvaluateExpr(priorityAttr.Expr, func(priority int) error {
+				key := fmt.Sprintf("%s/%d", listener, priority)
+
+				first, exists := declared[key]
+				if !exists {
+					declared[key] = address
+					return nil
+				}
+
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf("The priority %d is already declared by %s", priority, first),
+					priorityAttr.Expr.Range(),
+				)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsLbListenerRuleDuplicatePriority(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "duplicate priorities on the same listener",
+			Content: `
+resource "aws_lb_listener_rule" "api" {
+  listener_arn = aws_lb_listener.https.arn
+  priority     = 100
+}
+
+resource "aws_alb_listener_rule" "admin" {
+  listener_arn = aws_lb_listener.https.arn
+  priority     = 100
+}
+
+resource "aws_lb_listener_rule" "static" {
+  listener_arn = aws_lb_listener.http.arn
+  priority     = 100
+}
+
+resource "aws_lb_listener_rule" "auto" {
+  listener_arn = aws_lb_listener.https.arn
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbListenerRuleDuplicatePriorityRule(),
+					Message: "The priority 100 is already declared by aws_lb_listener_rule.api",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 18},
+						End:      hcl.Pos{Line: 9, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "literal listener ARNs",
+			Content: `
+resource "aws_lb_listener_rule" "api" {
+  listener_arn = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/main/1234567890abcdef/1234567890abcdef"
+  priority     = 10
+}
+
+resource "aws_lb_listener_rule" "admin" {
+  listener_arn = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/main/1234567890abcdef/1234567890abcdef"
+  priority     = 10
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbListenerRuleDuplicatePriorityRule(),
+					Message: "The priority 10 is already declared by aws_lb_listener_rule.api",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 18},
+						End:      hcl.Pos{Line: 9, Column: 20},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsLbListenerRuleDuplicatePriorityRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
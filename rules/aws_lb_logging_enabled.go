@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsLbLoggingEnabledRule checks whether load balancers enable access logs
+type AwsLbLoggingEnabledRule struct {
+	tflint.DefaultRule
+
+	resourceTypes   []string
+	defaultLogTypes []string
+}
+
+// NewAwsLbLoggingEnabledRule returns new rule with default attributes
+func NewAwsLbLoggingEnabledRule() *AwsLbLoggingEnabledRule {
+	return &AwsLbLoggingEnabledRule{
+		resourceTypes:   []string{"aws_lb", "aws_alb"},
+		defaultLogTypes: []string{"access_logs"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsLbLoggingEnabledRule) Name() string {
+	return "aws_lb_logging_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsLbLoggingEnabledRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsLbLoggingEnabledRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsLbLoggingEnabledRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether the blocks of the required log types are declared and enabled.
+// Gateway Load Balancers are ignored because they do not support logging.
+func (r *AwsLbLoggingEnabledRule) Check(runner tflint.Runner) error {
+	required, err := decodeRequiredLogTypes(runner, r, r.defaultLogTypes)
+	if err != nil {
+		return err
+	}
+	for _, logType := range required {
+		if logType != "access_logs" && logType != "connection_logs" {
+			return fmt.Errorf(`"%s" is an unknown log type. It must be "access_logs" or "connection_logs"`, logType)
+		}
+	}
+
+	logsSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "enabled"}},
+	}
+
+	for _, resourceType := range r.resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "load_balancer_type"}},
+			Blocks: []hclext.BlockSchema{
+				{Type: "access_logs", Body: logsSchema},
+				{Type: "connection_logs", Body: logsSchema},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			loadBalancerType := "application"
+			if attribute, exists := resource.Body.Attributes["load_balancer_type"]; exists {
+				loadBalancerType = ""
+				if err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+					loadBalancerType = val
+					return nil
+				}, nil); err != nil {
+					return err
+				}
+			}
+			if loadBalancerType == "" || loadBalancerType == "gateway" {
+				continue
+			}
+
+			for _, logType := range required {
+				// Connection logs are only supported by Application Load Balancers
+				if logType == "connection_logs" && loadBalancerType != "application" {
+					continue
+				}
+				if err := checkLoggingBlock(runner, r, resource, logType, "enabled"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
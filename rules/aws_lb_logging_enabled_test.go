@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsLbLoggingEnabled(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "access logs are not declared",
+			Content: `
+resource "aws_lb" "lb" {
+  name = "lb"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbLoggingEnabledRule(),
+					Message: "`access_logs` block should be declared to enable logging",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "access logs are disabled",
+			Content: `
+resource "aws_alb" "lb" {
+  name = "lb"
+
+  access_logs {
+    bucket  = "logs"
+    enabled = false
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbLoggingEnabledRule(),
+					Message: "`enabled` should be set to true in `access_logs` block",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 15},
+						End:      hcl.Pos{Line: 7, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "connection logs are required",
+			Content: `
+resource "aws_lb" "alb" {
+  name = "alb"
+
+  access_logs {
+    bucket  = "logs"
+    enabled = true
+  }
+}
+
+resource "aws_lb" "nlb" {
+  name               = "nlb"
+  load_balancer_type = "network"
+
+  access_logs {
+    bucket  = "logs"
+    enabled = true
+  }
+}
+
+resource "aws_lb" "gwlb" {
+  name               = "gwlb"
+  load_balancer_type = "gateway"
+}
+`,
+			Config: `
+rule "aws_lb_logging_enabled" {
+  enabled   = true
+  log_types = ["access_logs", "connection_logs"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbLoggingEnabledRule(),
+					Message: "`connection_logs` block should be declared to enable logging",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsLbLoggingEnabledRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
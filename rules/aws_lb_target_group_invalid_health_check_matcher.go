@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsLbTargetGroupInvalidHealthCheckMatcherRule checks whether matcher is declared for health checks that do not return status codes
+type AwsLbTargetGroupInvalidHealthCheckMatcherRule struct {
+	tflint.DefaultRule
+
+	// protocols are health check protocols that do not support matcher
+	protocols []string
+}
+
+// NewAwsLbTargetGroupInvalidHealthCheckMatcherRule returns new rule with default attributes
+func NewAwsLbTargetGroupInvalidHealthCheckMatcherRule() *AwsLbTargetGroupInvalidHealthCheckMatcherRule {
+	return &AwsLbTargetGroupInvalidHealthCheckMatcherRule{
+		protocols: []string{"TCP", "TLS", "UDP", "TCP_UDP"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsLbTargetGroupInvalidHealthCheckMatcherRule) Name() string {
+	return "aws_lb_target_group_invalid_health_check_matcher"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsLbTargetGroupInvalidHealthCheckMatcherRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsLbTargetGroupInvalidHealthCheckMatcherRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsLbTargetGroupInvalidHealthCheckMatcherRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether matcher is declared in health_check of TCP health checks.
+// If the protocol of the health check is omitted, the protocol of the target group is used.
+func (r *AwsLbTargetGroupInvalidHealthCheckMatcherRule) Check(runner tflint.Runner) error {
+	for _, resourceType := range lbTargetGroupResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "protocol"}},
+			Blocks: []hclext.BlockSchema{
+				{
+					Type: "health_check",
+					Body: &hclext.BodySchema{
+						Attributes: []hclext.AttributeSchema{
+							{Name: "protocol"},
+							{Name: "matcher"},
+						},
+					},
+				},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			for _, healthCheck := range resource.Body.Blocks {
+				set, err := isAttributeSet(runner, healthCheck.Body, "matcher")
+				if err != nil {
+					return err
+				}
+				if !set {
+					continue
+				}
+
+				body := resource.Body
+				if _, exists := healthCheck.Body.Attributes["protocol"]; exists {
+					body = healthCheck.Body
+				}
+				protocol, err := lbEvaluateString(runner, body, "protocol")
+				if err != nil {
+					return err
+				}
+				protocol = strings.ToUpper(protocol)
+				if !stringInSlice(protocol, r.protocols) {
+					continue
+				}
+
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("matcher cannot be set for %s health checks", protocol),
+					healthCheck.Body.Attributes["matcher"].Expr.Range(),
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
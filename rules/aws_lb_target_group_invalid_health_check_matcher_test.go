@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsLbTargetGroupInvalidHealthCheckMatcher(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "matcher in TCP target group",
+			Content: `
+resource "aws_lb_target_group" "tcp" {
+  port     = 80
+  protocol = "TCP"
+  vpc_id   = "vpc-1234abcd"
+
+  health_check {
+    matcher = "200"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbTargetGroupInvalidHealthCheckMatcherRule(),
+					Message: "matcher cannot be set for TCP health checks",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 15},
+						End:      hcl.Pos{Line: 8, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "matcher in TCP health check of aws_alb_target_group",
+			Content: `
+resource "aws_alb_target_group" "http" {
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = "vpc-1234abcd"
+
+  health_check {
+    protocol = "tcp"
+    matcher  = "200-299"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbTargetGroupInvalidHealthCheckMatcherRule(),
+					Message: "matcher cannot be set for TCP health checks",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 16},
+						End:      hcl.Pos{Line: 9, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "matcher in HTTP health check of TCP target group",
+			Content: `
+resource "aws_lb_target_group" "tcp" {
+  port     = 80
+  protocol = "TCP"
+  vpc_id   = "vpc-1234abcd"
+
+  health_check {
+    protocol = "HTTP"
+    path     = "/health"
+    matcher  = "200"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsLbTargetGroupInvalidHealthCheckMatcherRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
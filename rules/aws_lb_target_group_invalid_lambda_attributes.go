@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsLbTargetGroupInvalidLambdaAttributesRule checks whether target groups of Lambda functions declare attributes that only apply to other targets
+type AwsLbTargetGroupInvalidLambdaAttributesRule struct {
+	tflint.DefaultRule
+
+	attributeNames []string
+}
+
+// NewAwsLbTargetGroupInvalidLambdaAttributesRule returns new rule with default attributes
+func NewAwsLbTargetGroupInvalidLambdaAttributesRule() *AwsLbTargetGroupInvalidLambdaAttributesRule {
+	return &AwsLbTargetGroupInvalidLambdaAttributesRule{
+		attributeNames: []string{"port", "protocol"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsLbTargetGroupInvalidLambdaAttributesRule) Name() string {
+	return "aws_lb_target_group_invalid_lambda_attributes"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsLbTargetGroupInvalidLambdaAttributesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsLbTargetGroupInvalidLambdaAttributesRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsLbTargetGroupInvalidLambdaAttributesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether port or protocol is declared in target groups with target_type = "lambda"
+func (r *AwsLbTargetGroupInvalidLambdaAttributesRule) Check(runner tflint.Runner) error {
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "target_type"}},
+	}
+	for _, name := range r.attributeNames {
+		schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: name})
+	}
+
+	for _, resourceType := range lbTargetGroupResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, schema, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			targetType, err := lbEvaluateString(runner, resource.Body, "target_type")
+			if err != nil {
+				return err
+			}
+			if targetType != "lambda" {
+				continue
+			}
+
+			for _, name := range r.attributeNames {
+				set, err := isAttributeSet(runner, resource.Body, name)
+				if err != nil {
+					return err
+				}
+				if !set {
+					continue
+				}
+
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(`%s cannot be set when target_type is "lambda"`, name),
+					resource.Body.Attributes[name].Expr.Range(),
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
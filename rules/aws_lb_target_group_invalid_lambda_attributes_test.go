@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsLbTargetGroupInvalidLambdaAttributes(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "port and protocol in Lambda target group",
+			Content: `
+resource "aws_lb_target_group" "lambda" {
+  target_type = "lambda"
+  port        = 80
+  protocol    = "HTTP"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbTargetGroupInvalidLambdaAttributesRule(),
+					Message: `port cannot be set when target_type is "lambda"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 17},
+						End:      hcl.Pos{Line: 4, Column: 19},
+					},
+				},
+				{
+					Rule:    NewAwsLbTargetGroupInvalidLambdaAttributesRule(),
+					Message: `protocol cannot be set when target_type is "lambda"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 17},
+						End:      hcl.Pos{Line: 5, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "port in aws_alb_target_group",
+			Content: `
+resource "aws_alb_target_group" "lambda" {
+  target_type = "lambda"
+  port        = 80
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsLbTargetGroupInvalidLambdaAttributesRule(),
+					Message: `port cannot be set when target_type is "lambda"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 17},
+						End:      hcl.Pos{Line: 4, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "Lambda target group without port",
+			Content: `
+resource "aws_lb_target_group" "lambda" {
+  target_type = "lambda"
+  port        = null
+}
+
+resource "aws_lb_target_group" "instance" {
+  target_type = "instance"
+  port        = 80
+  protocol    = "HTTP"
+  vpc_id      = "vpc-1234abcd"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsLbTargetGroupInvalidLambdaAttributesRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
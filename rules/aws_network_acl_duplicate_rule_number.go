@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsNetworkACLDuplicateRuleNumberRule checks whether entries with the same rule number are declared in the same direction of a network ACL
+type AwsNetworkACLDuplicateRuleNumberRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsNetworkACLDuplicateRuleNumberRule returns new rule with default attributes
+func NewAwsNetworkACLDuplicateRuleNumberRule() *AwsNetworkACLDuplicateRuleNumberRule {
+	return &AwsNetworkACLDuplicateRuleNumberRule{}
+}
+
+// Name returns the rule name
+func (r *AwsNetworkACLDuplicateRuleNumberRule) Name() string {
+	return "aws_network_acl_duplicate_rule_number"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsNetworkACLDuplicateRuleNumberRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsNetworkACLDuplicateRuleNumberRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsNetworkACLDuplicateRuleNumberRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each rule number is unique in the direction of the network ACL.
+// Network ACLs are identified by resource addresses or literal network ACL IDs, and entries with unknown values are ignored.
+func (r *AwsNetworkACLDuplicateRuleNumberRule) Check(runner tflint.Runner) error {
+	// declared maps rule number keys to the first entry that declares them
+	declared := map[string]*networkACLEntry{}
+
+	return walkNetworkACLEntries(runner, func(entry *networkACLEntry) error {
+		if entry.acl == "" || entry.egress == nil {
+			return nil
+		}
+
+		attribute, exists := entry.body.Attributes[entry.ruleNumberAttribute]
+		if !exists {
+			return nil
+		}
+
+		return runner.EvaluateExpr(attribute.Expr, func(number int) error {
+			key := fmt.Sprintf("%s/%s/%d", entry.acl, entry.direction(), number)
+
+			first, exists := declared[key]
+			if !exists {
+				declared[key] = entry
+				return nil
+			}
+
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("The %s rule number %d is already declared by %s", entry.direction(), number, first),
+				attribute.Expr.Range(),
+			)
+		}, nil)
+	})
+}
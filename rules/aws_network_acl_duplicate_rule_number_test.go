@@ -0,0 +1,166 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsNetworkACLDuplicateRuleNumber(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "duplicate rule numbers in inline blocks",
+			Content: `
+resource "aws_network_acl" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  ingress {
+    rule_no    = 100
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.0.0.0/16"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  ingress {
+    rule_no    = 100
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.0.0.0/16"
+    from_port  = 80
+    to_port    = 80
+  }
+
+  egress {
+    rule_no    = 100
+    protocol   = "-1"
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 0
+    to_port    = 0
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLDuplicateRuleNumberRule(),
+					Message: "The ingress rule number 100 is already declared by an ingress block of aws_network_acl.main",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 18},
+						End:      hcl.Pos{Line: 15, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "duplicate rule numbers in aws_network_acl_rule",
+			Content: `
+resource "aws_network_acl" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  egress {
+    rule_no    = 200
+    protocol   = "-1"
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 0
+    to_port    = 0
+  }
+}
+
+resource "aws_network_acl_rule" "https" {
+  network_acl_id = aws_network_acl.main.id
+  rule_number    = 200
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "10.0.0.0/16"
+  from_port      = 443
+  to_port        = 443
+}
+
+resource "aws_network_acl_rule" "http" {
+  network_acl_id = aws_network_acl.main.id
+  rule_number    = 200
+  egress         = false
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "10.0.0.0/16"
+  from_port      = 80
+  to_port        = 80
+}
+
+resource "aws_network_acl_rule" "egress" {
+  network_acl_id = aws_network_acl.main.id
+  rule_number    = 200
+  egress         = true
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "10.0.0.0/16"
+  from_port      = 80
+  to_port        = 80
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLDuplicateRuleNumberRule(),
+					Message: "The ingress rule number 200 is already declared by aws_network_acl_rule.https",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 27, Column: 20},
+						End:      hcl.Pos{Line: 27, Column: 23},
+					},
+				},
+				{
+					Rule:    NewAwsNetworkACLDuplicateRuleNumberRule(),
+					Message: "The egress rule number 200 is already declared by aws_network_acl_rule.egress",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 18},
+						End:      hcl.Pos{Line: 6, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "same rule numbers in different network ACLs",
+			Content: `
+resource "aws_network_acl_rule" "public" {
+  network_acl_id = "acl-1234abcd"
+  rule_number    = 100
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "0.0.0.0/0"
+  from_port      = 443
+  to_port        = 443
+}
+
+resource "aws_network_acl_rule" "private" {
+  network_acl_id = "acl-5678abcd"
+  rule_number    = 100
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "10.0.0.0/16"
+  from_port      = 443
+  to_port        = 443
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsNetworkACLDuplicateRuleNumberRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
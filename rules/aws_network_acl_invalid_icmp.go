@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsNetworkACLInvalidICMPRule checks whether icmp_type and icmp_code are declared in network ACL entries for other protocols than ICMP
+type AwsNetworkACLInvalidICMPRule struct {
+	tflint.DefaultRule
+
+	attributeNames []string
+	// icmpProtocols are protocol numbers of ICMP and ICMPv6
+	icmpProtocols []int
+}
+
+// NewAwsNetworkACLInvalidICMPRule returns new rule with default attributes
+func NewAwsNetworkACLInvalidICMPRule() *AwsNetworkACLInvalidICMPRule {
+	return &AwsNetworkACLInvalidICMPRule{
+		attributeNames: []string{"icmp_type", "icmp_code"},
+		icmpProtocols:  []int{1, 58},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsNetworkACLInvalidICMPRule) Name() string {
+	return "aws_network_acl_invalid_icmp"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsNetworkACLInvalidICMPRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsNetworkACLInvalidICMPRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsNetworkACLInvalidICMPRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether icmp_type and icmp_code are declared with valid protocols other than ICMP and ICMPv6.
+// Invalid protocols are reported by aws_network_acl_invalid_protocol.
+func (r *AwsNetworkACLInvalidICMPRule) Check(runner tflint.Runner) error {
+	return walkNetworkACLEntries(runner, func(entry *networkACLEntry) error {
+		protocol, number, ok, err := networkACLEntryProtocol(runner, entry)
+		if err != nil {
+			return err
+		}
+		if !ok || intInSlice(number, r.icmpProtocols) {
+			return nil
+		}
+
+		for _, name := range r.attributeNames {
+			set, err := isAttributeSet(runner, entry.body, name)
+			if err != nil {
+				return err
+			}
+			if !set {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf(`%s is only used by ICMP, but the protocol is "%s"`, name, protocol),
+				entry.body.Attributes[name].Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsNetworkACLInvalidICMP(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "icmp_type and icmp_code with TCP",
+			Content: `
+resource "aws_network_acl_rule" "foo" {
+  network_acl_id = "acl-1234abcd"
+  rule_number    = 100
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "10.0.0.0/16"
+  from_port      = 443
+  to_port        = 443
+  icmp_type      = 8
+  icmp_code      = 0
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLInvalidICMPRule(),
+					Message: `icmp_type is only used by ICMP, but the protocol is "tcp"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 20},
+						End:      hcl.Pos{Line: 10, Column: 21},
+					},
+				},
+				{
+					Rule:    NewAwsNetworkACLInvalidICMPRule(),
+					Message: `icmp_code is only used by ICMP, but the protocol is "tcp"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 20},
+						End:      hcl.Pos{Line: 11, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "icmp_type with ICMP and ICMPv6",
+			Content: `
+resource "aws_network_acl" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  ingress {
+    rule_no    = 100
+    protocol   = "icmp"
+    action     = "allow"
+    cidr_block = "10.0.0.0/16"
+    from_port  = 0
+    to_port    = 0
+    icmp_type  = 8
+    icmp_code  = -1
+  }
+
+  ingress {
+    rule_no         = 110
+    protocol        = 58
+    action          = "allow"
+    ipv6_cidr_block = "::/0"
+    from_port       = 0
+    to_port         = 0
+    icmp_type       = 128
+    icmp_code       = -1
+  }
+
+  egress {
+    rule_no    = 100
+    protocol   = "udp"
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 53
+    to_port    = 53
+    icmp_type  = null
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsNetworkACLInvalidICMPRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
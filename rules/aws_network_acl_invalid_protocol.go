@@ -0,0 +1,59 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsNetworkACLInvalidProtocolRule checks whether "protocol" of network ACL entries has invalid value
+type AwsNetworkACLInvalidProtocolRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsNetworkACLInvalidProtocolRule returns new rule with default attributes
+func NewAwsNetworkACLInvalidProtocolRule() *AwsNetworkACLInvalidProtocolRule {
+	return &AwsNetworkACLInvalidProtocolRule{}
+}
+
+// Name returns the rule name
+func (r *AwsNetworkACLInvalidProtocolRule) Name() string {
+	return "aws_network_acl_invalid_protocol"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsNetworkACLInvalidProtocolRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsNetworkACLInvalidProtocolRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsNetworkACLInvalidProtocolRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether "protocol" is an IANA protocol number or name
+func (r *AwsNetworkACLInvalidProtocolRule) Check(runner tflint.Runner) error {
+	return walkNetworkACLEntries(runner, func(entry *networkACLEntry) error {
+		attribute, exists := entry.body.Attributes["protocol"]
+		if !exists {
+			return nil
+		}
+
+		return runner.EvaluateExpr(attribute.Expr, func(protocol string) error {
+			if _, ok := networkACLProtocolNumber(protocol); ok {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is an invalid protocol.", protocol),
+				attribute.Expr.Range(),
+			)
+		}, nil)
+	})
+}
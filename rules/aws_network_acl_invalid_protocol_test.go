@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsNetworkACLInvalidProtocol(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "invalid protocol name",
+			Content: `
+resource "aws_network_acl_rule" "foo" {
+  network_acl_id = "acl-1234abcd"
+  rule_number    = 100
+  protocol       = "https"
+  rule_action    = "allow"
+  cidr_block     = "10.0.0.0/16"
+  from_port      = 443
+  to_port        = 443
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLInvalidProtocolRule(),
+					Message: "\"https\" is an invalid protocol.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 20},
+						End:      hcl.Pos{Line: 5, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid protocol name in inline blocks",
+			Content: `
+resource "aws_default_network_acl" "main" {
+  default_network_acl_id = "acl-1234abcd"
+
+  ingress {
+    rule_no    = 100
+    protocol   = "ipv7"
+    action     = "allow"
+    cidr_block = "10.0.0.0/16"
+    from_port  = 0
+    to_port    = 0
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLInvalidProtocolRule(),
+					Message: "\"ipv7\" is an invalid protocol.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 18},
+						End:      hcl.Pos{Line: 7, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid protocols",
+			Content: `
+resource "aws_network_acl" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  ingress {
+    rule_no    = 100
+    protocol   = "TCP"
+    action     = "allow"
+    cidr_block = "10.0.0.0/16"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  ingress {
+    rule_no    = 110
+    protocol   = "esp"
+    action     = "allow"
+    cidr_block = "10.0.0.0/16"
+    from_port  = 0
+    to_port    = 0
+  }
+
+  egress {
+    rule_no    = 100
+    protocol   = -1
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 0
+    to_port    = 0
+  }
+
+  egress {
+    rule_no         = 110
+    protocol        = "58"
+    action          = "allow"
+    ipv6_cidr_block = "::/0"
+    from_port       = 0
+    to_port         = 0
+  }
+
+  egress {
+    rule_no         = 120
+    protocol        = "icmpv6"
+    action          = "allow"
+    ipv6_cidr_block = "::/0"
+    from_port       = 0
+    to_port         = 0
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsNetworkACLInvalidProtocolRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsNetworkACLInvalidRuleNumberRule checks whether rule numbers of network ACL entries are in the valid range
+type AwsNetworkACLInvalidRuleNumberRule struct {
+	tflint.DefaultRule
+
+	min int
+	max int
+}
+
+// NewAwsNetworkACLInvalidRuleNumberRule returns new rule with default attributes
+func NewAwsNetworkACLInvalidRuleNumberRule() *AwsNetworkACLInvalidRuleNumberRule {
+	return &AwsNetworkACLInvalidRuleNumberRule{
+		min: 1,
+		max: 32766,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsNetworkACLInvalidRuleNumberRule) Name() string {
+	return "aws_network_acl_invalid_rule_number"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsNetworkACLInvalidRuleNumberRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsNetworkACLInvalidRuleNumberRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsNetworkACLInvalidRuleNumberRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks rule_number of aws_network_acl_rule and rule_no of inline ingress/egress blocks
+func (r *AwsNetworkACLInvalidRuleNumberRule) Check(runner tflint.Runner) error {
+	return walkNetworkACLEntries(runner, func(entry *networkACLEntry) error {
+		attribute, exists := entry.body.Attributes[entry.ruleNumberAttribute]
+		if !exists {
+			return nil
+		}
+
+		return runner.EvaluateExpr(attribute.Expr, func(number int) error {
+			if number >= r.min && number <= r.max {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s must be between %d and %d, but is %d", entry.ruleNumberAttribute, r.min, r.max, number),
+				attribute.Expr.Range(),
+			)
+		}, nil)
+	})
+}
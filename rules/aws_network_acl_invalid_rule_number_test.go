@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsNetworkACLInvalidRuleNumber(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "rule_number is out of range",
+			Content: `
+resource "aws_network_acl_rule" "foo" {
+  network_acl_id = "acl-1234abcd"
+  rule_number    = 32767
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "10.0.0.0/16"
+  from_port      = 443
+  to_port        = 443
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLInvalidRuleNumberRule(),
+					Message: "rule_number must be between 1 and 32766, but is 32767",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 20},
+						End:      hcl.Pos{Line: 4, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "rule_no is out of range in inline blocks",
+			Content: `
+resource "aws_network_acl" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  ingress {
+    rule_no    = 0
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.0.0.0/16"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  egress {
+    rule_no    = 32766
+    protocol   = "-1"
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 0
+    to_port    = 0
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLInvalidRuleNumberRule(),
+					Message: "rule_no must be between 1 and 32766, but is 0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 18},
+						End:      hcl.Pos{Line: 6, Column: 19},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsNetworkACLInvalidRuleNumberRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
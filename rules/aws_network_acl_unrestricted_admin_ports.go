@@ -0,0 +1,252 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsNetworkACLUnrestrictedAdminPortsRule checks whether network ACLs allow administrative ports from anywhere
+type AwsNetworkACLUnrestrictedAdminPortsRule struct {
+	tflint.DefaultRule
+
+	defaultPorts []int
+}
+
+type awsNetworkACLUnrestrictedAdminPortsRuleConfig struct {
+	Ports []int `hclext:"ports,optional"`
+}
+
+// NewAwsNetworkACLUnrestrictedAdminPortsRule returns new rule with default attributes
+func NewAwsNetworkACLUnrestrictedAdminPortsRule() *AwsNetworkACLUnrestrictedAdminPortsRule {
+	return &AwsNetworkACLUnrestrictedAdminPortsRule{
+		// SSH and RDP
+		defaultPorts: []int{22, 3389},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsNetworkACLUnrestrictedAdminPortsRule) Name() string {
+	return "aws_network_acl_unrestricted_admin_ports"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsNetworkACLUnrestrictedAdminPortsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsNetworkACLUnrestrictedAdminPortsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsNetworkACLUnrestrictedAdminPortsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// networkACLAdminPortEntry is an ingress entry from all addresses whose rule number, action, protocol and ports are known
+type networkACLAdminPortEntry struct {
+	entry      *networkACLEntry
+	ruleNumber int
+	allow      bool
+	// source is "0.0.0.0/0" or "::/0"
+	source   string
+	protocol int
+	from     int
+	to       int
+}
+
+// covers returns whether the entry applies to the port of the protocol from the source
+func (e *networkACLAdminPortEntry) covers(protocol int, port int, source string) bool {
+	if e.protocol != -1 && e.protocol != protocol {
+		return false
+	}
+	if port < e.from || port > e.to {
+		return false
+	}
+	return isIPv6CIDR(e.source) == isIPv6CIDR(source)
+}
+
+// Check checks whether ingress entries allow administrative ports from 0.0.0.0/0 or ::/0.
+// Entries for all protocols allow every port, and entries for TCP and UDP allow ports between from_port and to_port.
+// As entries are evaluated in order of rule numbers, ports denied by a lower-numbered entry of the same network ACL are not reported.
+func (r *AwsNetworkACLUnrestrictedAdminPortsRule) Check(runner tflint.Runner) error {
+	config := awsNetworkACLUnrestrictedAdminPortsRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	ports := r.defaultPorts
+	if len(config.Ports) > 0 {
+		ports = config.Ports
+	}
+
+	entries := []*networkACLAdminPortEntry{}
+	err := walkNetworkACLEntries(runner, func(entry *networkACLEntry) error {
+		decoded, err := r.decodeEntry(runner, entry)
+		if err != nil || decoded == nil {
+			return err
+		}
+		entries = append(entries, decoded)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.allow {
+			continue
+		}
+
+		for _, port := range ports {
+			if !entry.covers(entry.protocol, port, entry.source) || r.denied(entries, entry, port) {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Port %d is allowed from %s", port, entry.source),
+				entry.entry.defRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// denied returns whether a lower-numbered entry of the same network ACL denies the port allowed by the entry
+func (r *AwsNetworkACLUnrestrictedAdminPortsRule) denied(entries []*networkACLAdminPortEntry, allowed *networkACLAdminPortEntry, port int) bool {
+	if allowed.entry.acl == "" {
+		return false
+	}
+
+	for _, entry := range entries {
+		if entry.allow || entry.entry.acl != allowed.entry.acl || entry.ruleNumber >= allowed.ruleNumber {
+			continue
+		}
+		// A deny entry for all protocols denies the port of any protocol
+		protocol := allowed.protocol
+		if protocol == -1 && entry.protocol != -1 {
+			continue
+		}
+		if entry.covers(protocol, port, allowed.source) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeEntry returns the ingress entry from all addresses, or nil if the entry is not such an entry or cannot be evaluated
+func (r *AwsNetworkACLUnrestrictedAdminPortsRule) decodeEntry(runner tflint.Runner, entry *networkACLEntry) (*networkACLAdminPortEntry, error) {
+	if entry.direction() != "ingress" {
+		return nil, nil
+	}
+
+	decoded := &networkACLAdminPortEntry{entry: entry}
+
+	attribute, exists := entry.body.Attributes[entry.ruleNumberAttribute]
+	if !exists {
+		return nil, nil
+	}
+	ruleNumberKnown := false
+	if err := runner.EvaluateExpr(attribute.Expr, func(number int) error {
+		decoded.ruleNumber, ruleNumberKnown = number, true
+		return nil
+	}, nil); err != nil || !ruleNumberKnown {
+		return nil, err
+	}
+
+	action, err := r.action(runner, entry)
+	if err != nil || action == "" {
+		return nil, err
+	}
+	decoded.allow = action == "allow"
+
+	decoded.source, err = r.unrestrictedSource(runner, entry)
+	if err != nil || decoded.source == "" {
+		return nil, err
+	}
+
+	_, protocol, ok, err := networkACLEntryProtocol(runner, entry)
+	if err != nil || !ok {
+		return nil, err
+	}
+	decoded.protocol = protocol
+
+	switch protocol {
+	case -1:
+		decoded.from, decoded.to = 0, 65535
+	case 6, 17:
+		fromKnown, toKnown := false, false
+		if attribute, exists := entry.body.Attributes["from_port"]; exists {
+			if err := runner.EvaluateExpr(attribute.Expr, func(port int) error {
+				decoded.from, fromKnown = port, true
+				return nil
+			}, nil); err != nil {
+				return nil, err
+			}
+		}
+		if attribute, exists := entry.body.Attributes["to_port"]; exists {
+			if err := runner.EvaluateExpr(attribute.Expr, func(port int) error {
+				decoded.to, toKnown = port, true
+				return nil
+			}, nil); err != nil {
+				return nil, err
+			}
+		}
+		if !fromKnown || !toKnown {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	return decoded, nil
+}
+
+// action returns the lower-cased action of the entry, or an empty string if it cannot be evaluated
+func (r *AwsNetworkACLUnrestrictedAdminPortsRule) action(runner tflint.Runner, entry *networkACLEntry) (string, error) {
+	attribute, exists := entry.body.Attributes[entry.actionAttribute]
+	if !exists {
+		return "", nil
+	}
+
+	action := ""
+	err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+		action = strings.ToLower(val)
+		return nil
+	}, nil)
+	return action, err
+}
+
+// unrestrictedSource returns the CIDR block of the entry if it matches all addresses, such as "0.0.0.0/0" and "::/0"
+func (r *AwsNetworkACLUnrestrictedAdminPortsRule) unrestrictedSource(runner tflint.Runner, entry *networkACLEntry) (string, error) {
+	source := ""
+	for _, name := range []string{"cidr_block", "ipv6_cidr_block"} {
+		attribute, exists := entry.body.Attributes[name]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(cidr string) error {
+			if prefix, err := netip.ParsePrefix(cidr); err == nil && prefix.Bits() == 0 {
+				source = cidr
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	return source, nil
+}
+
+// isIPv6CIDR returns whether the CIDR block is an IPv6 address range
+func isIPv6CIDR(cidr string) bool {
+	prefix, err := netip.ParsePrefix(cidr)
+	return err == nil && prefix.Addr().Is6()
+}
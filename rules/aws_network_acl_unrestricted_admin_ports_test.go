@@ -0,0 +1,191 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsNetworkACLUnrestrictedAdminPorts(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "SSH from anywhere",
+			Content: `
+resource "aws_network_acl_rule" "ssh" {
+  network_acl_id = "acl-1234abcd"
+  rule_number    = 100
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "0.0.0.0/0"
+  from_port      = 22
+  to_port        = 22
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLUnrestrictedAdminPortsRule(),
+					Message: "Port 22 is allowed from 0.0.0.0/0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "all protocols from anywhere in inline blocks",
+			Content: `
+resource "aws_network_acl" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  ingress {
+    rule_no         = 100
+    protocol        = "-1"
+    action          = "allow"
+    ipv6_cidr_block = "::/0"
+    from_port       = 0
+    to_port         = 0
+  }
+
+  ingress {
+    rule_no    = 110
+    protocol   = "tcp"
+    action     = "deny"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 22
+    to_port    = 22
+  }
+
+  ingress {
+    rule_no    = 120
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.0.0.0/16"
+    from_port  = 22
+    to_port    = 22
+  }
+
+  egress {
+    rule_no    = 100
+    protocol   = "-1"
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 0
+    to_port    = 0
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLUnrestrictedAdminPortsRule(),
+					Message: "Port 22 is allowed from ::/0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 10},
+					},
+				},
+				{
+					Rule:    NewAwsNetworkACLUnrestrictedAdminPortsRule(),
+					Message: "Port 3389 is allowed from ::/0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			Name: "denied by lower rule numbers",
+			Content: `
+resource "aws_network_acl" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  ingress {
+    rule_no    = 90
+    protocol   = "tcp"
+    action     = "deny"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 22
+    to_port    = 22
+  }
+
+  ingress {
+    rule_no    = 100
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 0
+    to_port    = 65535
+  }
+
+  ingress {
+    rule_no    = 110
+    protocol   = "tcp"
+    action     = "deny"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 3389
+    to_port    = 3389
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLUnrestrictedAdminPortsRule(),
+					Message: "Port 3389 is allowed from 0.0.0.0/0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 3},
+						End:      hcl.Pos{Line: 14, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom ports",
+			Content: `
+resource "aws_network_acl_rule" "high" {
+  network_acl_id = "acl-1234abcd"
+  rule_number    = 100
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "0.0.0.0/0"
+  from_port      = 1024
+  to_port        = 65535
+}`,
+			Config: `
+rule "aws_network_acl_unrestricted_admin_ports" {
+  enabled = true
+  ports   = [22, 5432]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsNetworkACLUnrestrictedAdminPortsRule(),
+					Message: "Port 5432 is allowed from 0.0.0.0/0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 39},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsNetworkACLUnrestrictedAdminPortsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
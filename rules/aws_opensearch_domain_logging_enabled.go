@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsOpensearchDomainLoggingEnabledRule checks whether OpenSearch domains publish logs to CloudWatch Logs
+type AwsOpensearchDomainLoggingEnabledRule struct {
+	tflint.DefaultRule
+
+	resourceType    string
+	blockName       string
+	defaultLogTypes []string
+}
+
+// NewAwsOpensearchDomainLoggingEnabledRule returns new rule with default attributes
+func NewAwsOpensearchDomainLoggingEnabledRule() *AwsOpensearchDomainLoggingEnabledRule {
+	return &AwsOpensearchDomainLoggingEnabledRule{
+		resourceType:    "aws_opensearch_domain",
+		blockName:       "log_publishing_options",
+		defaultLogTypes: []string{},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsOpensearchDomainLoggingEnabledRule) Name() string {
+	return "aws_opensearch_domain_logging_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsOpensearchDomainLoggingEnabledRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsOpensearchDomainLoggingEnabledRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsOpensearchDomainLoggingEnabledRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether log_publishing_options blocks enable the required log types
+func (r *AwsOpensearchDomainLoggingEnabledRule) Check(runner tflint.Runner) error {
+	required, err := decodeRequiredLogTypes(runner, r, r.defaultLogTypes)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "log_type"}, {Name: "enabled"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		enabled := []string{}
+		known := true
+
+		for _, options := range resource.Body.Blocks {
+			attribute, exists := options.Body.Attributes["log_type"]
+			if !exists {
+				continue
+			}
+
+			var logType string
+			if err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+				logType = val
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+			if logType == "" {
+				known = false
+				continue
+			}
+
+			// Log publishing is enabled by default
+			logEnabled := true
+			if attribute, exists := options.Body.Attributes["enabled"]; exists {
+				evaluated := false
+				if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+					logEnabled = val
+					evaluated = true
+					return nil
+				}, nil); err != nil {
+					return err
+				}
+				if !evaluated {
+					known = false
+					continue
+				}
+			}
+			if logEnabled {
+				enabled = append(enabled, logType)
+			}
+		}
+
+		if !known {
+			continue
+		}
+		if err := emitMissingLogTypes(runner, r, r.blockName, enabled, required, resource.DefRange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsOpensearchDomainLoggingEnabled(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "logs are not published",
+			Content: `
+resource "aws_opensearch_domain" "domain" {
+  domain_name = "domain"
+
+  log_publishing_options {
+    cloudwatch_log_group_arn = "arn:aws:logs:us-east-1:111111111111:log-group:opensearch"
+    log_type                 = "INDEX_SLOW_LOGS"
+    enabled                  = false
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsOpensearchDomainLoggingEnabledRule(),
+					Message: "`log_publishing_options` should enable at least one log type",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "required logs are not published",
+			Content: `
+resource "aws_opensearch_domain" "domain" {
+  domain_name = "domain"
+
+  log_publishing_options {
+    cloudwatch_log_group_arn = "arn:aws:logs:us-east-1:111111111111:log-group:opensearch"
+    log_type                 = "ES_APPLICATION_LOGS"
+  }
+}
+`,
+			Config: `
+rule "aws_opensearch_domain_logging_enabled" {
+  enabled   = true
+  log_types = ["ES_APPLICATION_LOGS", "AUDIT_LOGS"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsOpensearchDomainLoggingEnabledRule(),
+					Message: "`log_publishing_options` should enable \"AUDIT_LOGS\"",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "logs are published",
+			Content: `
+resource "aws_opensearch_domain" "domain" {
+  domain_name = "domain"
+
+  log_publishing_options {
+    cloudwatch_log_group_arn = "arn:aws:logs:us-east-1:111111111111:log-group:opensearch"
+    log_type                 = "AUDIT_LOGS"
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "enabled cannot be evaluated",
+			Content: `
+resource "aws_opensearch_domain" "domain" {
+  domain_name = "domain"
+
+  log_publishing_options {
+    cloudwatch_log_group_arn = "arn:aws:logs:us-east-1:111111111111:log-group:opensearch"
+    log_type                 = "AUDIT_LOGS"
+    enabled                  = module.logging.enabled
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsOpensearchDomainLoggingEnabledRule()
+
+	for _, tc := range cases {
+		runner := newUnknownModuleRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
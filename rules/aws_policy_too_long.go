@@ -0,0 +1,200 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsPolicyTooLongRule checks that IAM and organization policies do not exceed the size quotas
+type AwsPolicyTooLongRule struct {
+	tflint.DefaultRule
+
+	limits          []policyLengthLimit
+	roleInlineLimit int
+}
+
+// NewAwsPolicyTooLongRule returns new rule with default attributes
+func NewAwsPolicyTooLongRule() *AwsPolicyTooLongRule {
+	return &AwsPolicyTooLongRule{
+		// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length
+		limits: []policyLengthLimit{
+			{resourceType: "aws_iam_policy", attributeName: "policy", limit: 6144},
+			{resourceType: "aws_iam_group_policy", attributeName: "policy", limit: 5120},
+			{resourceType: "aws_iam_role_policy", attributeName: "policy", limit: 10240},
+			{resourceType: "aws_iam_user_policy", attributeName: "policy", limit: 2048},
+			// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html
+			{resourceType: "aws_organizations_policy", attributeName: "content", limit: 5120, policyTypes: []string{"SERVICE_CONTROL_POLICY"}, defaultPolicyType: "SERVICE_CONTROL_POLICY"},
+			{resourceType: "aws_organizations_policy", attributeName: "content", limit: 10000, policyTypes: []string{"TAG_POLICY", "BACKUP_POLICY"}},
+			{resourceType: "aws_organizations_policy", attributeName: "content", limit: 2500, policyTypes: []string{"AISERVICES_OPT_OUT_POLICY"}},
+		},
+		roleInlineLimit: 10240,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsPolicyTooLongRule) Name() string {
+	return "aws_policy_too_long"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsPolicyTooLongRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsPolicyTooLongRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsPolicyTooLongRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks the length of each policy and the combined length of inline policies per role
+func (r *AwsPolicyTooLongRule) Check(runner tflint.Runner) error {
+	checker, err := newPolicyLengthChecker(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, limit := range r.limits {
+		if err := checker.check(r, limit); err != nil {
+			return err
+		}
+	}
+
+	return r.checkRoleInlinePolicies(runner, checker)
+}
+
+// roleInlinePolicies is the combined length of inline policies attached to a role
+type roleInlinePolicies struct {
+	length   int
+	reported bool
+}
+
+// checkRoleInlinePolicies checks the combined length of inline policies declared in `inline_policy` blocks
+// of aws_iam_role and in aws_iam_role_policy. Roles are identified by resource addresses or literal names.
+func (r *AwsPolicyTooLongRule) checkRoleInlinePolicies(runner tflint.Runner, checker *policyLengthChecker) error {
+	roles, err := runner.GetResourceContent("aws_iam_role", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "name"}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "inline_policy",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "policy"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	policies, err := runner.GetResourceContent("aws_iam_role_policy", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "role"}, {Name: "policy"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// addresses maps literal role names to resource addresses
+	addresses := map[string]string{}
+	totals := map[string]*roleInlinePolicies{}
+
+	// add adds the length of the policy to the total of the role.
+	// Inline policies of aws_iam_role are also checked one by one, since aws_iam_role_policy is checked by its limit.
+	add := func(role string, attribute *hclext.Attribute, inline bool) error {
+		if totals[role] == nil {
+			totals[role] = &roleInlinePolicies{}
+		}
+		total := totals[role]
+
+		return checker.evaluate(attribute, func(length int) error {
+			total.length += length
+
+			// A single policy exceeding the quota is reported by itself rather than by the combined length
+			if length > r.roleInlineLimit {
+				total.reported = true
+				if !inline {
+					return nil
+				}
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf("The policy length is %d characters and is limited to %d characters.", length, r.roleInlineLimit),
+					attribute.Expr.Range(),
+				)
+			}
+
+			if total.reported || total.length <= r.roleInlineLimit {
+				return nil
+			}
+			total.reported = true
+
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("The combined length of inline policies of %s is %d characters and is limited to %d characters.", role, total.length, r.roleInlineLimit),
+				attribute.Expr.Range(),
+			)
+		})
+	}
+
+	for _, resource := range roles.Blocks {
+		address := fmt.Sprintf("aws_iam_role.%s", resource.Labels[1])
+
+		if attr, exists := resource.Body.Attributes["name"]; exists {
+			if err := runner.EvaluateExpr(attr.Expr, func(name string) error {
+				addresses[name] = address
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+		}
+
+		for _, block := range resource.Body.Blocks {
+			attribute, exists := block.Body.Attributes["policy"]
+			if !exists {
+				continue
+			}
+			if err := add(address, attribute, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, resource := range policies.Blocks {
+		roleAttr, exists := resource.Body.Attributes["role"]
+		if !exists {
+			continue
+		}
+		policyAttr, exists := resource.Body.Attributes["policy"]
+		if !exists {
+			continue
+		}
+
+		role, ok := resourceReference(roleAttr.Expr, "aws_iam_role", "id", "name")
+		if !ok {
+			if err := runner.EvaluateExpr(roleAttr.Expr, func(name string) error {
+				role = name
+				if address, exists := addresses[name]; exists {
+					role = address
+				}
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+		}
+		if role == "" {
+			continue
+		}
+
+		if err := add(role, policyAttr, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsPolicyTooLong(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "user policy is too long",
+			Content: `
+resource "aws_iam_user_policy" "policy" {
+  name   = "test_policy"
+  user   = "test_user"
+  policy = <<-EOF
+{
+  "Statement": [
+    {
+      "Action": "` + strings.Repeat("a", 2020) + `"
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPolicyTooLongRule(),
+					Message: "The policy length is 2049 characters and is limited to 2048 characters.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 12},
+						End:      hcl.Pos{Line: 13, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "organizations policies",
+			Content: `
+resource "aws_organizations_policy" "scp" {
+  name    = "scp"
+  content = "{\"Statement\":[{\"Action\":\"` + strings.Repeat("a", 5100) + `\"}]}"
+}
+
+resource "aws_organizations_policy" "tag" {
+  name    = "tag"
+  type    = "TAG_POLICY"
+  content = "{\"Statement\":[{\"Action\":\"` + strings.Repeat("a", 5100) + `\"}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPolicyTooLongRule(),
+					Message: "The policy length is 5129 characters and is limited to 5120 characters.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 13},
+						End:      hcl.Pos{Line: 4, Column: 5150},
+					},
+				},
+			},
+		},
+		{
+			Name: "managed and group policies",
+			Content: `
+resource "aws_iam_policy" "policy" {
+  name   = "test_policy"
+  policy = "{\"Statement\":[{\"Action\":\"` + strings.Repeat("a", 6120) + `\"}]}"
+}
+
+resource "aws_iam_group_policy" "policy" {
+  name   = "test_policy"
+  group  = "test_group"
+  policy = "{\"Statement\":[{\"Action\":\"` + strings.Repeat("a", 5100) + `\"}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPolicyTooLongRule(),
+					Message: "The policy length is 6149 characters and is limited to 6144 characters.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 6169},
+					},
+				},
+				{
+					Rule:    NewAwsPolicyTooLongRule(),
+					Message: "The policy length is 5129 characters and is limited to 5120 characters.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 12},
+						End:      hcl.Pos{Line: 10, Column: 5149},
+					},
+				},
+			},
+		},
+		{
+			Name: "policy document is too long",
+			Content: `
+data "aws_iam_policy_document" "policy" {
+  statement {
+    actions = ["` + strings.Repeat("a", 10200) + `"]
+  }
+}
+
+resource "aws_iam_role_policy" "policy" {
+  name   = "test_policy"
+  role   = "test_role"
+  policy = data.aws_iam_policy_document.policy.json
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPolicyTooLongRule(),
+					Message: "The policy length is 10269 characters and is limited to 10240 characters.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 12},
+						End:      hcl.Pos{Line: 11, Column: 52},
+					},
+				},
+			},
+		},
+		{
+			Name: "inline policy of role is too long",
+			Content: `
+resource "aws_iam_role" "role" {
+  name = "test_role"
+
+  inline_policy {
+    name   = "inline"
+    policy = "{\"Statement\":[{\"Action\":\"` + strings.Repeat("a", 16000) + `\"}]}"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPolicyTooLongRule(),
+					Message: "The policy length is 16029 characters and is limited to 10240 characters.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 14},
+						End:      hcl.Pos{Line: 7, Column: 16051},
+					},
+				},
+			},
+		},
+		{
+			Name: "combined inline policies are too long",
+			Content: `
+resource "aws_iam_role" "role" {
+  name = "test_role"
+
+  inline_policy {
+    name   = "inline"
+    policy = "{\"Statement\":[{\"Action\":\"` + strings.Repeat("a", 4000) + `\"}]}"
+  }
+}
+
+resource "aws_iam_role_policy" "first" {
+  name   = "first"
+  role   = aws_iam_role.role.id
+  policy = "{\"Statement\":[{\"Action\":\"` + strings.Repeat("a", 4000) + `\"}]}"
+}
+
+resource "aws_iam_role_policy" "second" {
+  name   = "second"
+  role   = "test_role"
+  policy = "{\"Statement\":[{\"Action\":\"` + strings.Repeat("a", 4000) + `\"}]}"
+}
+
+resource "aws_iam_role_policy" "other" {
+  name   = "other"
+  role   = "other_role"
+  policy = "{\"Statement\":[{\"Action\":\"` + strings.Repeat("a", 4000) + `\"}]}"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsPolicyTooLongRule(),
+					Message: "The combined length of inline policies of aws_iam_role.role is 12087 characters and is limited to 10240 characters.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 20, Column: 12},
+						End:      hcl.Pos{Line: 20, Column: 4049},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsPolicyTooLongRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsRdsClusterLoggingEnabledRule checks whether RDS clusters export logs to CloudWatch Logs
+type AwsRdsClusterLoggingEnabledRule struct {
+	tflint.DefaultRule
+
+	resourceType    string
+	attributeName   string
+	defaultLogTypes []string
+}
+
+// NewAwsRdsClusterLoggingEnabledRule returns new rule with default attributes
+func NewAwsRdsClusterLoggingEnabledRule() *AwsRdsClusterLoggingEnabledRule {
+	return &AwsRdsClusterLoggingEnabledRule{
+		resourceType:    "aws_rds_cluster",
+		attributeName:   "enabled_cloudwatch_logs_exports",
+		defaultLogTypes: []string{},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsRdsClusterLoggingEnabledRule) Name() string {
+	return "aws_rds_cluster_logging_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsRdsClusterLoggingEnabledRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsRdsClusterLoggingEnabledRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsRdsClusterLoggingEnabledRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether enabled_cloudwatch_logs_exports includes the required log types
+func (r *AwsRdsClusterLoggingEnabledRule) Check(runner tflint.Runner) error {
+	required, err := decodeRequiredLogTypes(runner, r, r.defaultLogTypes)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if err := checkLogTypesAttribute(runner, r, resource, r.attributeName, required); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
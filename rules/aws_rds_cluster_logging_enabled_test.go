@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsRdsClusterLoggingEnabled(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no logs are exported",
+			Content: `
+resource "aws_rds_cluster" "cluster" {
+  engine = "aurora-postgresql"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRdsClusterLoggingEnabledRule(),
+					Message: "`enabled_cloudwatch_logs_exports` should enable at least one log type",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "required logs are not exported",
+			Content: `
+resource "aws_rds_cluster" "cluster" {
+  engine                          = "aurora-mysql"
+  enabled_cloudwatch_logs_exports = ["error"]
+}
+`,
+			Config: `
+rule "aws_rds_cluster_logging_enabled" {
+  enabled   = true
+  log_types = ["audit", "error", "slowquery"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRdsClusterLoggingEnabledRule(),
+					Message: "`enabled_cloudwatch_logs_exports` should enable \"audit\", \"slowquery\"",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 37},
+						End:      hcl.Pos{Line: 4, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "logs are exported",
+			Content: `
+resource "aws_rds_cluster" "cluster" {
+  engine                          = "aurora-postgresql"
+  enabled_cloudwatch_logs_exports = ["postgresql"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsRdsClusterLoggingEnabledRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
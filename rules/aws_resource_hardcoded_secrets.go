@@ -0,0 +1,283 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"golang.org/x/exp/maps"
+)
+
+// AwsResourceHardcodedSecretsRule checks whether credentials are hard-coded in attributes that are stored in plain text
+type AwsResourceHardcodedSecretsRule struct {
+	tflint.DefaultRule
+
+	defaultPatterns map[string]string
+	// providerAttributes are attributes of the AWS provider that must not be hard-coded
+	providerAttributes []string
+}
+
+type awsResourceHardcodedSecretsRuleConfig struct {
+	Patterns               map[string]string `hclext:"patterns,optional"`
+	ExcludeDefaultPatterns bool              `hclext:"exclude_default_patterns,optional"`
+}
+
+// secretPattern is a named regular expression that matches a secret
+type secretPattern struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// NewAwsResourceHardcodedSecretsRule returns new rule with default attributes
+func NewAwsResourceHardcodedSecretsRule() *AwsResourceHardcodedSecretsRule {
+	return &AwsResourceHardcodedSecretsRule{
+		defaultPatterns: map[string]string{
+			"AWS access key ID":     `\b(?:A3T[A-Z0-9]|AKIA|ASIA|ABIA|ACCA)[A-Z2-7]{16}\b`,
+			"AWS secret access key": `(?i)aws.{0,20}?(?:secret|private).{0,20}?['"=:\s]+[A-Za-z0-9/+]{40}\b`,
+			"private key":           `-----BEGIN (?:[A-Z0-9]+ )?PRIVATE KEY(?: BLOCK)?-----`,
+			"GitHub token":          `\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`,
+			"Slack token":           `\bxox[baprs]-[A-Za-z0-9-]{10,}\b`,
+		},
+		providerAttributes: []string{"access_key", "secret_key"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsResourceHardcodedSecretsRule) Name() string {
+	return "aws_resource_hardcoded_secrets"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsResourceHardcodedSecretsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsResourceHardcodedSecretsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsResourceHardcodedSecretsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether attributes match secret patterns.
+// Like sensitive attributes in the SDK-based rules, issues do not include the matched value.
+func (r *AwsResourceHardcodedSecretsRule) Check(runner tflint.Runner) error {
+	config := awsResourceHardcodedSecretsRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	patterns, err := r.compilePatterns(config)
+	if err != nil {
+		return err
+	}
+
+	if err := r.checkProviders(runner); err != nil {
+		return err
+	}
+
+	for _, target := range []struct {
+		resourceType  string
+		attributeName string
+	}{
+		{resourceType: "aws_instance", attributeName: "user_data"},
+		{resourceType: "aws_ecs_task_definition", attributeName: "container_definitions"},
+	} {
+		resources, err := runner.GetResourceContent(target.resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: target.attributeName}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attribute, exists := resource.Body.Attributes[target.attributeName]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+				return r.emitMatches(runner, patterns, fmt.Sprintf("`%s`", target.attributeName), val, attribute)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := r.checkLambdaFunctions(runner, patterns); err != nil {
+		return err
+	}
+
+	return r.checkSSMParameters(runner, patterns)
+}
+
+// compilePatterns returns the default patterns merged with the configured patterns, sorted by name
+func (r *AwsResourceHardcodedSecretsRule) compilePatterns(config awsResourceHardcodedSecretsRuleConfig) ([]secretPattern, error) {
+	sources := map[string]string{}
+	if !config.ExcludeDefaultPatterns {
+		for name, source := range r.defaultPatterns {
+			sources[name] = source
+		}
+	}
+	for name, source := range config.Patterns {
+		sources[name] = source
+	}
+
+	names := maps.Keys(sources)
+	sort.Strings(names)
+
+	patterns := make([]secretPattern, len(names))
+	for i, name := range names {
+		pattern, err := regexp.Compile(sources[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile the pattern of %s: %w", name, err)
+		}
+		patterns[i] = secretPattern{name: name, pattern: pattern}
+	}
+	return patterns, nil
+}
+
+// emitMatches emits an issue for each pattern that matches the value
+func (r *AwsResourceHardcodedSecretsRule) emitMatches(runner tflint.Runner, patterns []secretPattern, subject string, val string, attribute *hclext.Attribute) error {
+	for _, pattern := range patterns {
+		if !pattern.pattern.MatchString(val) {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s contains a hard-coded %s", subject, pattern.name),
+			attribute.Expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkProviders checks whether access_key and secret_key of the AWS provider are literals.
+// Values from variables are allowed, since they can be passed from the environment.
+func (r *AwsResourceHardcodedSecretsRule) checkProviders(runner tflint.Runner) error {
+	attributes := []hclext.AttributeSchema{}
+	for _, name := range r.providerAttributes {
+		attributes = append(attributes, hclext.AttributeSchema{Name: name})
+	}
+
+	providers, err := runner.GetProviderContent("aws", &hclext.BodySchema{Attributes: attributes}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, provider := range providers.Blocks {
+		for _, name := range r.providerAttributes {
+			attribute, exists := provider.Body.Attributes[name]
+			if !exists || len(attribute.Expr.Variables()) > 0 {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+				if val == "" {
+					return nil
+				}
+				return runner.EmitIssue(r, fmt.Sprintf("`%s` of the provider is hard-coded", name), attribute.Expr.Range())
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkLambdaFunctions checks each value in `environment.variables`
+func (r *AwsResourceHardcodedSecretsRule) checkLambdaFunctions(runner tflint.Runner, patterns []secretPattern) error {
+	resources, err := runner.GetResourceContent("aws_lambda_function", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "environment",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "variables"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, environment := range resource.Body.Blocks {
+			attribute, exists := environment.Body.Attributes["variables"]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(variables map[string]string) error {
+				keys := maps.Keys(variables)
+				sort.Strings(keys)
+
+				for _, key := range keys {
+					if err := r.emitMatches(runner, patterns, fmt.Sprintf("`environment.variables.%s`", key), variables[key], attribute); err != nil {
+						return err
+					}
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSSMParameters checks the value of parameters that are not encrypted.
+// The type defaults to "String" if omitted.
+func (r *AwsResourceHardcodedSecretsRule) checkSSMParameters(runner tflint.Runner, patterns []secretPattern) error {
+	resources, err := runner.GetResourceContent("aws_ssm_parameter", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "type"}, {Name: "value"}, {Name: "insecure_value"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		parameterType := "String"
+		if attribute, exists := resource.Body.Attributes["type"]; exists {
+			parameterType = ""
+			if err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+				parameterType = val
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+		}
+		if parameterType != "String" && parameterType != "StringList" {
+			continue
+		}
+
+		for _, name := range []string{"value", "insecure_value"} {
+			attribute, exists := resource.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+				return r.emitMatches(runner, patterns, fmt.Sprintf("`%s` of the %s parameter", name, parameterType), val, attribute)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/aws"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"github.com/zclconf/go-cty/cty"
+	"golang.org/x/exp/maps"
+)
+
+// AwsResourceInvalidARNRegionRule checks whether ARNs that must be in the same region are in the region of the provider
+type AwsResourceInvalidARNRegionRule struct {
+	tflint.DefaultRule
+
+	// attributes maps resource types to the attributes of ARNs that must be in the region of the resource
+	attributes map[string][]string
+}
+
+// NewAwsResourceInvalidARNRegionRule returns new rule with default attributes
+func NewAwsResourceInvalidARNRegionRule() *AwsResourceInvalidARNRegionRule {
+	return &AwsResourceInvalidARNRegionRule{
+		attributes: map[string][]string{
+			"aws_alb_listener":                       {"certificate_arn"},
+			"aws_alb_listener_certificate":           {"certificate_arn"},
+			"aws_api_gateway_domain_name":            {"regional_certificate_arn"},
+			"aws_autoscaling_group":                  {"target_group_arns"},
+			"aws_cloudwatch_log_group":               {"kms_key_id"},
+			"aws_cloudwatch_log_subscription_filter": {"destination_arn"},
+			"aws_db_instance":                        {"kms_key_id", "performance_insights_kms_key_id"},
+			"aws_ebs_volume":                         {"kms_key_id"},
+			"aws_lambda_event_source_mapping":        {"event_source_arn"},
+			"aws_lambda_function":                    {"kms_key_arn"},
+			"aws_lb_listener":                        {"certificate_arn"},
+			"aws_lb_listener_certificate":            {"certificate_arn"},
+			"aws_rds_cluster":                        {"kms_key_id"},
+			"aws_secretsmanager_secret":              {"kms_key_id"},
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsResourceInvalidARNRegionRule) Name() string {
+	return "aws_resource_invalid_arn_region"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsResourceInvalidARNRegionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsResourceInvalidARNRegionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsResourceInvalidARNRegionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check compares the region segment of ARNs with the region of the provider.
+// Resources whose provider region cannot be determined, key IDs and aliases, ARNs without regions
+// and CloudWatch Logs destinations are skipped.
+func (r *AwsResourceInvalidARNRegionRule) Check(runner tflint.Runner) error {
+	regions, err := aws.NewRegionResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	resourceTypes := maps.Keys(r.attributes)
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		schema := &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "provider"}},
+		}
+		for _, name := range r.attributes[resourceType] {
+			schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: name})
+		}
+
+		resources, err := runner.GetResourceContent(resourceType, schema, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			region := regions.ResourceRegion(resource)
+			if region == "" {
+				continue
+			}
+
+			for _, name := range r.attributes[resourceType] {
+				attribute, exists := resource.Body.Attributes[name]
+				if !exists {
+					continue
+				}
+
+				err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
+					for _, arn := range knownStrings(val) {
+						parts := strings.SplitN(arn, ":", 6)
+						if len(parts) < 6 || parts[0] != "arn" || parts[3] == "" || parts[3] == region {
+							continue
+						}
+						// CloudWatch Logs destinations can receive subscriptions from other regions
+						if parts[2] == "logs" && strings.HasPrefix(parts[5], "destination:") {
+							continue
+						}
+
+						if err := runner.EmitIssue(
+							r,
+							fmt.Sprintf(`The ARN "%s" is in %s, but the provider region is %s`, arn, parts[3], region),
+							attribute.Expr.Range(),
+						); err != nil {
+							return err
+						}
+					}
+					return nil
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
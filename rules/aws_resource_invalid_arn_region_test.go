@@ -0,0 +1,156 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsResourceInvalidARNRegion(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "different region",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lb_listener" "https" {
+  certificate_arn = "arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidARNRegionRule(),
+					Message: `The ARN "arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012" is in us-west-2, but the provider region is us-east-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 21},
+						End:      hcl.Pos{Line: 7, Column: 106},
+					},
+				},
+			},
+		},
+		{
+			Name: "list of ARNs",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_autoscaling_group" "main" {
+  target_group_arns = [
+    "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/1234567890123456",
+    "arn:aws:elasticloadbalancing:eu-west-1:123456789012:targetgroup/web/1234567890123456",
+  ]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidARNRegionRule(),
+					Message: `The ARN "arn:aws:elasticloadbalancing:eu-west-1:123456789012:targetgroup/web/1234567890123456" is in eu-west-1, but the provider region is us-east-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 23},
+						End:      hcl.Pos{Line: 10, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "key ID",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_ebs_volume" "main" {
+  kms_key_id = "12345678-1234-1234-1234-123456789012"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "same region",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lambda_event_source_mapping" "main" {
+  event_source_arn = "arn:aws:sqs:us-east-1:123456789012:queue"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "cross-region log destination",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_cloudwatch_log_subscription_filter" "main" {
+  destination_arn = "arn:aws:logs:eu-west-1:123456789012:destination:central"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "aliased provider",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+
+resource "aws_cloudwatch_log_group" "main" {
+  provider = aws.west
+
+  kms_key_id = "arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidARNRegionRule(),
+					Message: `The ARN "arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012" is in us-east-1, but the provider region is us-west-2`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 16},
+						End:      hcl.Pos{Line: 14, Column: 93},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown region",
+			Content: `
+resource "aws_lambda_function" "main" {
+  kms_key_arn = "arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsResourceInvalidARNRegionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/aws"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"github.com/zclconf/go-cty/cty"
+	"golang.org/x/exp/maps"
+)
+
+// AwsResourceInvalidAvailabilityZoneRule checks whether availability zones are in the region of the provider
+type AwsResourceInvalidAvailabilityZoneRule struct {
+	tflint.DefaultRule
+
+	// attributes maps resource types to the attributes of availability zone names
+	attributes map[string][]string
+	// zonePattern matches availability zone names, Local Zone names and Wavelength Zone names.
+	// Availability zone IDs such as "use1-az1" do not match.
+	zonePattern *regexp.Regexp
+}
+
+// NewAwsResourceInvalidAvailabilityZoneRule returns new rule with default attributes
+func NewAwsResourceInvalidAvailabilityZoneRule() *AwsResourceInvalidAvailabilityZoneRule {
+	return &AwsResourceInvalidAvailabilityZoneRule{
+		attributes: map[string][]string{
+			"aws_autoscaling_group":        {"availability_zones"},
+			"aws_db_instance":              {"availability_zone"},
+			"aws_default_subnet":           {"availability_zone"},
+			"aws_dms_replication_instance": {"availability_zone"},
+			"aws_docdb_cluster":            {"availability_zones"},
+			"aws_ebs_volume":               {"availability_zone"},
+			"aws_efs_file_system":          {"availability_zone_name"},
+			"aws_elasticache_cluster":      {"availability_zone", "preferred_availability_zones"},
+			"aws_elb":                      {"availability_zones"},
+			"aws_instance":                 {"availability_zone"},
+			"aws_neptune_cluster":          {"availability_zones"},
+			"aws_rds_cluster":              {"availability_zones"},
+			"aws_redshift_cluster":         {"availability_zone"},
+			"aws_spot_instance_request":    {"availability_zone"},
+			"aws_subnet":                   {"availability_zone"},
+		},
+		zonePattern: regexp.MustCompile(`^([a-z]{2}(?:-[a-z]+)+-\d+)(?:[a-z]|-[a-z0-9-]+)$`),
+	}
+}
+
+// Name returns the rule name
+func (r *AwsResourceInvalidAvailabilityZoneRule) Name() string {
+	return "aws_resource_invalid_availability_zone"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsResourceInvalidAvailabilityZoneRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsResourceInvalidAvailabilityZoneRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsResourceInvalidAvailabilityZoneRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check compares the region of availability zone names with the region of the provider.
+// Resources whose provider region cannot be determined are skipped.
+func (r *AwsResourceInvalidAvailabilityZoneRule) Check(runner tflint.Runner) error {
+	regions, err := aws.NewRegionResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	resourceTypes := maps.Keys(r.attributes)
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		schema := &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "provider"}},
+		}
+		for _, name := range r.attributes[resourceType] {
+			schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: name})
+		}
+
+		resources, err := runner.GetResourceContent(resourceType, schema, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			region := regions.ResourceRegion(resource)
+			if region == "" {
+				continue
+			}
+
+			for _, name := range r.attributes[resourceType] {
+				attribute, exists := resource.Body.Attributes[name]
+				if !exists {
+					continue
+				}
+
+				err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
+					for _, zone := range knownStrings(val) {
+						matches := r.zonePattern.FindStringSubmatch(zone)
+						if matches == nil || matches[1] == region {
+							continue
+						}
+
+						if err := runner.EmitIssue(
+							r,
+							fmt.Sprintf(`The availability zone "%s" is in %s, but the provider region is %s`, zone, matches[1], region),
+							attribute.Expr.Range(),
+						); err != nil {
+							return err
+						}
+					}
+					return nil
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsResourceInvalidAvailabilityZone(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "different region",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_subnet" "main" {
+  availability_zone = "us-west-2a"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidAvailabilityZoneRule(),
+					Message: `The availability zone "us-west-2a" is in us-west-2, but the provider region is us-east-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 23},
+						End:      hcl.Pos{Line: 7, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "list of zones",
+			Content: `
+provider "aws" {
+  region = "eu-west-1"
+}
+
+resource "aws_autoscaling_group" "main" {
+  availability_zones = ["eu-west-1a", "eu-central-1b"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidAvailabilityZoneRule(),
+					Message: `The availability zone "eu-central-1b" is in eu-central-1, but the provider region is eu-west-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 24},
+						End:      hcl.Pos{Line: 7, Column: 55},
+					},
+				},
+			},
+		},
+		{
+			Name: "Local Zone in the same region",
+			Content: `
+provider "aws" {
+  region = "us-west-2"
+}
+
+resource "aws_subnet" "main" {
+  availability_zone = "us-west-2-lax-1a"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "availability zone ID",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_subnet" "main" {
+  availability_zone = "usw2-az1"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "aliased provider",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "tokyo"
+  region = "ap-northeast-1"
+}
+
+resource "aws_ebs_volume" "main" {
+  provider = aws.tokyo
+
+  availability_zone = "us-east-1a"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidAvailabilityZoneRule(),
+					Message: `The availability zone "us-east-1a" is in us-east-1, but the provider region is ap-northeast-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 23},
+						End:      hcl.Pos{Line: 14, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown region",
+			Content: `
+resource "aws_instance" "main" {
+  availability_zone = "us-west-2a"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsResourceInvalidAvailabilityZoneRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
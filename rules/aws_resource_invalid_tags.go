@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"github.com/terraform-linters/tflint-ruleset-aws/rules/tags"
+)
+
+// AwsResourceInvalidTagsRule checks whether tags satisfy the limits of AWS
+type AwsResourceInvalidTagsRule struct {
+	tflint.DefaultRule
+
+	maxTags        int
+	maxKeyLength   int
+	maxValueLength int
+	reservedPrefix string
+	// characters matches tag keys and values that only contain allowed characters
+	characters *regexp.Regexp
+	// anyCharacterResourceTypes are EC2 resources that accept any Unicode characters in tags
+	anyCharacterResourceTypes map[string]bool
+}
+
+// NewAwsResourceInvalidTagsRule returns new rule with default attributes
+func NewAwsResourceInvalidTagsRule() *AwsResourceInvalidTagsRule {
+	// https://docs.aws.amazon.com/tag-editor/latest/userguide/tagging.html#tag-conventions
+	return &AwsResourceInvalidTagsRule{
+		maxTags:        50,
+		maxKeyLength:   128,
+		maxValueLength: 256,
+		reservedPrefix: "aws:",
+		characters:     regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`),
+		// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Using_Tags.html#tag-restrictions
+		anyCharacterResourceTypes: map[string]bool{
+			"aws_ami":                   true,
+			"aws_customer_gateway":      true,
+			"aws_ebs_snapshot":          true,
+			"aws_ebs_volume":            true,
+			"aws_eip":                   true,
+			"aws_instance":              true,
+			"aws_internet_gateway":      true,
+			"aws_key_pair":              true,
+			"aws_launch_template":       true,
+			"aws_nat_gateway":           true,
+			"aws_network_acl":           true,
+			"aws_network_interface":     true,
+			"aws_placement_group":       true,
+			"aws_route_table":           true,
+			"aws_security_group":        true,
+			"aws_spot_instance_request": true,
+			"aws_subnet":                true,
+			"aws_vpc":                   true,
+			"aws_vpc_endpoint":          true,
+			"aws_vpn_connection":        true,
+			"aws_vpn_gateway":           true,
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsResourceInvalidTagsRule) Name() string {
+	return "aws_resource_invalid_tags"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsResourceInvalidTagsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsResourceInvalidTagsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsResourceInvalidTagsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks the tags of resources merged with the default tags of the provider
+func (r *AwsResourceInvalidTagsRule) Check(runner tflint.Runner) error {
+	providerTagsMap, err := getProviderLevelTags(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, resourceType := range tags.Resources {
+		err := walkResourceTags(runner, resourceType, providerTagsMap, func(resourceTags *tagSet, location hcl.Range) error {
+			return r.checkTags(runner, resourceType, resourceTags, location)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkTags checks the declared tags. Values that cannot be evaluated are skipped.
+// Characters are not checked for EC2 resources, as EC2 allows any Unicode characters.
+func (r *AwsResourceInvalidTagsRule) checkTags(runner tflint.Runner, resourceType string, resourceTags *tagSet, location hcl.Range) error {
+	checkCharacters := !r.anyCharacterResourceTypes[resourceType] && !strings.HasPrefix(resourceType, "aws_ec2_")
+
+	if len(resourceTags.values) > r.maxTags {
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("The resource has %d tags, but at most %d tags are allowed.", len(resourceTags.values), r.maxTags),
+			location,
+		); err != nil {
+			return err
+		}
+	}
+
+	// seen maps lower-cased keys to the first key that declares them
+	seen := map[string]string{}
+
+	for _, key := range resourceTags.keys() {
+		value, known := resourceTags.value(key)
+
+		var messages []string
+		switch {
+		case utf8.RuneCountInString(key) > r.maxKeyLength:
+			messages = append(messages, fmt.Sprintf(`The tag key "%s" is longer than %d characters.`, key, r.maxKeyLength))
+		case checkCharacters && !r.characters.MatchString(key):
+			messages = append(messages, fmt.Sprintf(`The tag key "%s" contains invalid characters.`, key))
+		}
+		if strings.HasPrefix(strings.ToLower(key), r.reservedPrefix) {
+			messages = append(messages, fmt.Sprintf(`The tag key "%s" uses the reserved prefix "%s".`, key, r.reservedPrefix))
+		}
+		switch {
+		case known && utf8.RuneCountInString(value) > r.maxValueLength:
+			messages = append(messages, fmt.Sprintf(`The value of tag "%s" is longer than %d characters.`, key, r.maxValueLength))
+		case checkCharacters && known && !r.characters.MatchString(value):
+			messages = append(messages, fmt.Sprintf(`The value of tag "%s" contains invalid characters.`, key))
+		}
+		if other, exists := seen[strings.ToLower(key)]; exists {
+			messages = append(messages, fmt.Sprintf(`The tag keys "%s" and "%s" differ only in case.`, other, key))
+		} else {
+			seen[strings.ToLower(key)] = key
+		}
+
+		for _, message := range messages {
+			if err := runner.EmitIssue(r, message, location); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
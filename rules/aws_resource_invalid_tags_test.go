@@ -0,0 +1,190 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsResourceInvalidTags(t *testing.T) {
+	tooManyTags := make([]string, 51)
+	for i := range tooManyTags {
+		tooManyTags[i] = fmt.Sprintf(`    Tag%d = "value"`, i)
+	}
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "too many tags",
+			Content: `
+resource "aws_instance" "main" {
+  tags = {
+` + strings.Join(tooManyTags, "\n") + `
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidTagsRule(),
+					Message: "The resource has 51 tags, but at most 50 tags are allowed.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 55, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "too long key and value",
+			Content: `
+resource "aws_instance" "main" {
+  tags = {
+    ` + strings.Repeat("k", 129) + ` = "value"
+    Name = "` + strings.Repeat("v", 257) + `"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidTagsRule(),
+					Message: `The value of tag "Name" is longer than 256 characters.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 6, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsResourceInvalidTagsRule(),
+					Message: `The tag key "` + strings.Repeat("k", 129) + `" is longer than 128 characters.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 6, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid characters, reserved prefix and duplicate keys",
+			Content: `
+resource "aws_s3_bucket" "main" {
+  tags = {
+    "aws:owner" = "team"
+    Name        = "main"
+    name        = "main"
+    Owner       = "team|platform"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidTagsRule(),
+					Message: `The value of tag "Owner" contains invalid characters.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 8, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsResourceInvalidTagsRule(),
+					Message: `The tag key "aws:owner" uses the reserved prefix "aws:".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 8, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsResourceInvalidTagsRule(),
+					Message: `The tag keys "Name" and "name" differ only in case.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 8, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "duplicate key with default tags",
+			Content: `
+provider "aws" {
+  default_tags {
+    tags = {
+      Environment = "prod"
+    }
+  }
+}
+
+resource "aws_s3_bucket" "main" {
+  tags = {
+    environment = "prod"
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceInvalidTagsRule(),
+					Message: `The tag keys "Environment" and "environment" differ only in case.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 10},
+						End:      hcl.Pos{Line: 13, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid tags",
+			Content: `
+resource "aws_instance" "main" {
+  tags = {
+    Name               = "web server"
+    "team:owner"       = "platform@example.com"
+    CostCenter         = "1234"
+    "kubernetes.io/ns" = "default"
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "EC2 resources accept any characters",
+			Content: `
+resource "aws_instance" "main" {
+  tags = {
+    Owner = "team|platform"
+  }
+}
+
+resource "aws_ec2_host" "main" {
+  tags = {
+    "owner#team" = "platform"
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsResourceInvalidTagsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
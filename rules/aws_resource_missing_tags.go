@@ -1,8 +1,8 @@
 package rules
 
 import (
-	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -10,11 +10,9 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
-	"github.com/terraform-linters/tflint-ruleset-aws/aws"
 	"github.com/terraform-linters/tflint-ruleset-aws/project"
 	"github.com/terraform-linters/tflint-ruleset-aws/rules/tags"
 	"github.com/zclconf/go-cty/cty"
-	"golang.org/x/exp/maps"
 )
 
 // AwsResourceMissingTagsRule checks whether resources are tagged correctly
@@ -23,16 +21,54 @@ type AwsResourceMissingTagsRule struct {
 }
 
 type awsResourceTagsRuleConfig struct {
-	Tags    []string `hclext:"tags"`
-	Exclude []string `hclext:"exclude,optional"`
+	Tags      []string                         `hclext:"tags,optional"`
+	Exclude   []string                         `hclext:"exclude,optional"`
+	Tag       []awsResourceTagConstraintConfig `hclext:"tag,block"`
+	Resources []awsResourceTagsOverrideConfig  `hclext:"resource,block"`
 }
 
-const (
-	defaultTagsBlockName  = "default_tags"
-	tagsAttributeName     = "tags"
-	tagBlockName          = "tag"
-	providerAttributeName = "provider"
-)
+// awsResourceTagConstraintConfig constrains the value of a tag, such as `tag "Environment" { values = ["dev", "prod"] }`.
+// The constraints only apply if the tag is present. Add the key to "tags" to require it as well.
+type awsResourceTagConstraintConfig struct {
+	Key      string   `hclext:"key,label"`
+	Values   []string `hclext:"values,optional"`
+	Pattern  string   `hclext:"pattern,optional"`
+	NonEmpty bool     `hclext:"non_empty,optional"`
+
+	pattern *regexp.Regexp
+}
+
+// awsResourceTagsOverrideConfig replaces the required tags for a resource type, such as `resource "aws_s3_bucket" { tags = ["Owner"] }`
+type awsResourceTagsOverrideConfig struct {
+	Type string   `hclext:"type,label"`
+	Tags []string `hclext:"tags"`
+}
+
+// requiredTags returns the tags required for the resource type
+func (c *awsResourceTagsRuleConfig) requiredTags(resourceType string) []string {
+	for _, override := range c.Resources {
+		if override.Type == resourceType {
+			return override.Tags
+		}
+	}
+	return c.Tags
+}
+
+// compilePatterns compiles the patterns of tag value constraints
+func (c *awsResourceTagsRuleConfig) compilePatterns() error {
+	for i, constraint := range c.Tag {
+		if constraint.Pattern == "" {
+			continue
+		}
+
+		pattern, err := regexp.Compile(constraint.Pattern)
+		if err != nil {
+			return fmt.Errorf(`invalid pattern of tag "%s": %w`, constraint.Key, err)
+		}
+		c.Tag[i].pattern = pattern
+	}
+	return nil
+}
 
 // NewAwsResourceMissingTagsRule returns new rules for all resources that support tags
 func NewAwsResourceMissingTagsRule() *AwsResourceMissingTagsRule {
@@ -59,93 +95,17 @@ func (r *AwsResourceMissingTagsRule) Link() string {
 	return project.ReferenceLink(r.Name())
 }
 
-func (r *AwsResourceMissingTagsRule) getProviderLevelTags(runner tflint.Runner) (map[string]map[string]string, error) {
-	providerSchema := &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{
-			{
-				Name:     "alias",
-				Required: false,
-			},
-		},
-		Blocks: []hclext.BlockSchema{
-			{
-				Type: defaultTagsBlockName,
-				Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: tagsAttributeName}}},
-			},
-		},
-	}
-
-	providerBody, err := runner.GetProviderContent("aws", providerSchema, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get provider default tags
-	allProviderTags := make(map[string]map[string]string)
-	var providerAlias string
-	for _, provider := range providerBody.Blocks.OfType(providerAttributeName) {
-		// Get the alias attribute, in terraform when there is a single aws provider its called "default"
-		providerAttr, ok := provider.Body.Attributes["alias"]
-		if !ok {
-			providerAlias = "default"
-		} else {
-			err := runner.EvaluateExpr(providerAttr.Expr, func(alias string) error {
-				logger.Debug("Walk `%s` provider", providerAlias)
-				providerAlias = alias
-				// Init the provider reference even if it doesn't have tags
-				allProviderTags[alias] = nil
-				return nil
-			}, nil)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		for _, block := range provider.Body.Blocks {
-			providerTags := make(map[string]string)
-			attr, ok := block.Body.Attributes[tagsAttributeName]
-			if !ok {
-				continue
-			}
-
-			err := runner.EvaluateExpr(attr.Expr, func(val cty.Value) error {
-				// Skip unknown values
-				if !val.IsKnown() || val.IsNull() {
-					logger.Warn("The missing aws tags rule can only evaluate provided variables, skipping %s.", provider.Labels[0]+"."+providerAlias+"."+defaultTagsBlockName+"."+tagsAttributeName)
-					return nil
-				}
-
-				valMap := val.AsValueMap()
-				var tags map[string]string = make(map[string]string, len(valMap))
-				for k, v := range valMap {
-					tags[k] = ""
-					if !v.IsNull() && v.Type().FriendlyName() == "string" {
-						tags[k] = v.AsString()
-					}
-				}
-				logger.Debug("Walk `%s` provider with tags `%v`", providerAlias, tags)
-				providerTags = tags
-				return nil
-			}, nil)
-
-			if err != nil {
-				return nil, err
-			}
-
-			allProviderTags[providerAlias] = providerTags
-		}
-	}
-	return allProviderTags, nil
-}
-
 // Check checks resources for missing tags
 func (r *AwsResourceMissingTagsRule) Check(runner tflint.Runner) error {
 	config := awsResourceTagsRuleConfig{}
 	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
 		return err
 	}
+	if err := config.compilePatterns(); err != nil {
+		return err
+	}
 
-	providerTagsMap, err := r.getProviderLevelTags(runner)
+	providerTagsMap, err := getProviderLevelTags(runner)
 
 	if err != nil {
 		return err
@@ -157,83 +117,13 @@ func (r *AwsResourceMissingTagsRule) Check(runner tflint.Runner) error {
 			continue
 		}
 
-		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
-			Attributes: []hclext.AttributeSchema{
-				{Name: tagsAttributeName},
-				{Name: providerAttributeName},
-			},
-		}, nil)
+		err := walkResourceTags(runner, resourceType, providerTagsMap, func(resourceTags *tagSet, location hcl.Range) error {
+			r.emitIssue(runner, resourceType, resourceTags, config, location)
+			return nil
+		})
 		if err != nil {
 			return err
 		}
-
-		if resources.IsEmpty() {
-			continue
-		}
-
-		for _, resource := range resources.Blocks {
-			providerAlias := "default"
-			// Override the provider alias if defined
-			if val, ok := resource.Body.Attributes[providerAttributeName]; ok {
-				provider, diagnostics := aws.DecodeProviderConfigRef(val.Expr, "provider")
-				providerAlias = provider.Alias
-
-				if _, hasProvider := providerTagsMap[providerAlias]; !hasProvider {
-					errString := fmt.Sprintf(
-						"The aws provider with alias \"%s\" doesn't exist.",
-						providerAlias,
-					)
-					logger.Error("Error querying provider tags: %s", errString)
-					return errors.New(errString)
-				}
-
-				if diagnostics.HasErrors() {
-					logger.Error("error decoding provider: %w", diagnostics)
-					return diagnostics
-				}
-			}
-
-			resourceTags := make(map[string]string)
-
-			// The provider tags are to be overriden
-			// https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags
-			maps.Copy(resourceTags, providerTagsMap[providerAlias])
-
-			// If the resource has a tags attribute
-			if attribute, okResource := resource.Body.Attributes[tagsAttributeName]; okResource {
-				logger.Debug(
-					"Walk `%s` attribute",
-					resource.Labels[0]+"."+resource.Labels[1]+"."+tagsAttributeName,
-				)
-
-				err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
-					// Skip unknown values
-					if !val.IsKnown() || val.IsNull() {
-						logger.Warn("The missing aws tags rule can only evaluate provided variables, skipping %s.", resource.Labels[0]+"."+resource.Labels[1]+"."+tagsAttributeName)
-						return nil
-					}
-					valMap := val.AsValueMap()
-					// Since the evlaluateExpr, overrides k/v pairs, we need to re-copy the tags
-					var evaluatedTags map[string]string = make(map[string]string, len(valMap))
-					for k, v := range valMap {
-						evaluatedTags[k] = ""
-						if !v.IsNull() && v.Type().FriendlyName() == "string" {
-							evaluatedTags[k] = v.AsString()
-						}
-					}
-					maps.Copy(resourceTags, evaluatedTags)
-					r.emitIssue(runner, resourceTags, config, attribute.Expr.Range())
-					return nil
-				}, nil)
-
-				if err != nil {
-					return err
-				}
-			} else {
-				logger.Debug("Walk `%s` resource", resource.Labels[0]+"."+resource.Labels[1])
-				r.emitIssue(runner, resourceTags, config, resource.DefRange)
-			}
-		}
 	}
 
 	// Special handling for tags on aws_autoscaling_group resources
@@ -241,16 +131,17 @@ func (r *AwsResourceMissingTagsRule) Check(runner tflint.Runner) error {
 		return err
 	}
 
-	return nil
-}
+	// Tags of instances and volumes launched from aws_launch_template
+	if err := r.checkAwsLaunchTemplates(runner, config); err != nil {
+		return err
+	}
+
+	// Tags of volumes attached to aws_instance
+	if err := r.checkAwsInstanceVolumes(runner, config, providerTagsMap); err != nil {
+		return err
+	}
 
-// awsAutoscalingGroupTag is used by go-cty to evaluate tags in aws_autoscaling_group resources
-// The type does not need to be public, but its fields do
-// https://github.com/zclconf/go-cty/blob/master/docs/gocty.md#converting-to-and-from-structs
-type awsAutoscalingGroupTag struct {
-	Key               string `cty:"key"`
-	Value             string `cty:"value"`
-	PropagateAtLaunch bool   `cty:"propagate_at_launch"`
+	return nil
 }
 
 // checkAwsAutoScalingGroups handles the special case for tags on AutoScaling Groups
@@ -263,130 +154,255 @@ func (r *AwsResourceMissingTagsRule) checkAwsAutoScalingGroups(runner tflint.Run
 		return nil
 	}
 
-	resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{}, nil)
+	resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: tagsAttributeName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: tagBlockName,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "key"},
+						{Name: "value"},
+					},
+				},
+			},
+			// Dynamic blocks remain if they cannot be expanded, such as for_each of unknown values
+			{
+				Type:       "dynamic",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, nil)
 	if err != nil {
 		return err
 	}
 
 	for _, resource := range resources.Blocks {
-		asgTagBlockTags, tagBlockLocation, err := r.checkAwsAutoScalingGroupsTag(runner, config, resource)
+		asgTagBlockTags, err := r.checkAwsAutoScalingGroupsTag(runner, resource)
 		if err != nil {
 			return err
 		}
 
-		asgTagsAttributeTags, tagsAttributeLocation, err := r.checkAwsAutoScalingGroupsTags(runner, config, resource)
-		if err != nil {
-			return err
+		asgTagsAttributeTags := newTagSet()
+		tagsAttributeLocation := resource.DefRange
+		if attribute, exists := resource.Body.Attributes[tagsAttributeName]; exists {
+			asgTagsAttributeTags, err = r.checkAwsAutoScalingGroupsTags(runner, attribute)
+			if err != nil {
+				return err
+			}
+			tagsAttributeLocation = attribute.Expr.Range()
 		}
 
 		switch {
-		case len(asgTagBlockTags) > 0 && len(asgTagsAttributeTags) > 0:
+		case !asgTagBlockTags.isEmpty() && !asgTagsAttributeTags.isEmpty():
 			runner.EmitIssue(r, "Only tag block or tags attribute may be present, but found both", resource.DefRange)
-		case len(asgTagBlockTags) == 0 && len(asgTagsAttributeTags) == 0:
-			r.emitIssue(runner, map[string]string{}, config, resource.DefRange)
-		case len(asgTagBlockTags) > 0 && len(asgTagsAttributeTags) == 0:
-			tags := asgTagBlockTags
-			location := tagBlockLocation
-			r.emitIssue(runner, tags, config, location)
-		case len(asgTagBlockTags) == 0 && len(asgTagsAttributeTags) > 0:
-			tags := asgTagsAttributeTags
-			location := tagsAttributeLocation
-			r.emitIssue(runner, tags, config, location)
+		case asgTagBlockTags.isEmpty() && asgTagsAttributeTags.isEmpty():
+			r.emitIssue(runner, resourceType, newTagSet(), config, resource.DefRange)
+		case !asgTagBlockTags.isEmpty():
+			r.emitIssue(runner, resourceType, asgTagBlockTags, config, resource.DefRange)
+		default:
+			r.emitIssue(runner, resourceType, asgTagsAttributeTags, config, tagsAttributeLocation)
 		}
 	}
 
 	return nil
 }
 
-// checkAwsAutoScalingGroupsTag checks tag{} blocks on aws_autoscaling_group resources
-func (r *AwsResourceMissingTagsRule) checkAwsAutoScalingGroupsTag(runner tflint.Runner, config awsResourceTagsRuleConfig, resourceBlock *hclext.Block) (map[string]string, hcl.Range, error) {
-	tags := make(map[string]string)
+// checkAwsAutoScalingGroupsTag checks tag{} blocks on aws_autoscaling_group resources.
+// The tags are unknown if dynamic tag blocks cannot be expanded.
+func (r *AwsResourceMissingTagsRule) checkAwsAutoScalingGroupsTag(runner tflint.Runner, resource *hclext.Block) (*tagSet, error) {
+	tags := newTagSet()
+
+	for _, tag := range resource.Body.Blocks {
+		if tag.Type == "dynamic" {
+			if tag.Labels[0] == tagBlockName {
+				logger.Debug("Walk dynamic tag block of `%s`", resource.Labels[0]+"."+resource.Labels[1])
+				tags.unknown = true
+			}
+			continue
+		}
+
+		attribute, exists := tag.Body.Attributes["key"]
+		if !exists {
+			return tags, fmt.Errorf(`Did not find expected field "key" in aws_autoscaling_group "%s" starting at line %d`, resource.Labels[1], resource.DefRange.Start.Line)
+		}
+
+		var key cty.Value
+		err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
+			key, _ = val.UnmarkDeep()
+			return nil
+		}, &tflint.EvaluateExprOption{WantType: &cty.String})
+		if err != nil {
+			return tags, err
+		}
+		if key.IsNull() || !key.IsKnown() {
+			tags.unknown = true
+			continue
+		}
+
+		value := cty.NullVal(cty.String)
+		if attribute, exists := tag.Body.Attributes["value"]; exists {
+			value = cty.UnknownVal(cty.String)
+			err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
+				value, _ = val.UnmarkDeep()
+				return nil
+			}, nil)
+			if err != nil {
+				return tags, err
+			}
+		}
+		tags.values[key.AsString()] = value
+	}
+
+	return tags, nil
+}
+
+// checkAwsAutoScalingGroupsTags checks the tags attribute on aws_autoscaling_group resources
+func (r *AwsResourceMissingTagsRule) checkAwsAutoScalingGroupsTags(runner tflint.Runner, attribute *hclext.Attribute) (*tagSet, error) {
+	tags := &tagSet{values: map[string]cty.Value{}, unknown: true}
+
+	err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
+		val, _ = val.UnmarkDeep()
+		if !val.IsKnown() || (!val.IsNull() && !val.CanIterateElements()) {
+			return nil
+		}
+		tags.unknown = false
+		if val.IsNull() {
+			return nil
+		}
+
+		for it := val.ElementIterator(); it.Next(); {
+			_, tag := it.Element()
+			if !tag.IsKnown() || tag.IsNull() || !tag.Type().IsObjectType() || !tag.Type().HasAttribute("key") {
+				tags.unknown = true
+				continue
+			}
+
+			key := tag.GetAttr("key")
+			if !key.IsKnown() || key.IsNull() || key.Type() != cty.String {
+				tags.unknown = true
+				continue
+			}
+			value := cty.NullVal(cty.String)
+			if tag.Type().HasAttribute("value") {
+				value = tag.GetAttr("value")
+			}
+			tags.values[key.AsString()] = value
+		}
+		return nil
+	}, nil)
+
+	return tags, err
+}
+
+// checkAwsLaunchTemplates checks the tags of tag_specifications blocks on aws_launch_template resources.
+// The default tags of the provider are not applied to the resources launched from the template.
+func (r *AwsResourceMissingTagsRule) checkAwsLaunchTemplates(runner tflint.Runner, config awsResourceTagsRuleConfig) error {
+	resourceType := "aws_launch_template"
+
+	if stringInSlice(resourceType, config.Exclude) {
+		return nil
+	}
 
-	resources, err := runner.GetResourceContent("aws_autoscaling_group", &hclext.BodySchema{
+	resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
 		Blocks: []hclext.BlockSchema{
 			{
-				Type: tagBlockName,
+				Type: "tag_specifications",
 				Body: &hclext.BodySchema{
-					Attributes: []hclext.AttributeSchema{
-						{Name: "key"},
-					},
+					Attributes: []hclext.AttributeSchema{{Name: tagsAttributeName}},
 				},
 			},
 		},
 	}, nil)
 	if err != nil {
-		return tags, hcl.Range{}, err
+		return err
 	}
 
 	for _, resource := range resources.Blocks {
-		if resource.Labels[0] != resourceBlock.Labels[0] {
-			continue
-		}
-
-		for _, tag := range resource.Body.Blocks {
-			attribute, exists := tag.Body.Attributes["key"]
+		for _, block := range resource.Body.Blocks {
+			attribute, exists := block.Body.Attributes[tagsAttributeName]
 			if !exists {
-				return tags, hcl.Range{}, fmt.Errorf(`Did not find expected field "key" in aws_autoscaling_group "%s" starting at line %d`, resource.Labels[0], resource.DefRange.Start.Line)
+				r.emitIssue(runner, resourceType, newTagSet(), config, block.DefRange)
+				continue
 			}
 
-			err := runner.EvaluateExpr(attribute.Expr, func(key string) error {
-				tags[key] = ""
-				return nil
-			}, nil)
+			specificationTags, err := evaluateTags(runner, attribute.Expr)
 			if err != nil {
-				return tags, hcl.Range{}, err
+				return err
 			}
+			r.emitIssue(runner, resourceType, specificationTags, config, attribute.Expr.Range())
 		}
 	}
 
-	return tags, resourceBlock.DefRange, nil
+	return nil
 }
 
-// checkAwsAutoScalingGroupsTag checks the tags attribute on aws_autoscaling_group resources
-func (r *AwsResourceMissingTagsRule) checkAwsAutoScalingGroupsTags(runner tflint.Runner, config awsResourceTagsRuleConfig, resourceBlock *hclext.Block) (map[string]string, hcl.Range, error) {
-	tags := make(map[string]string)
+// checkAwsInstanceVolumes checks volume_tags, and tags of root_block_device and ebs_block_device blocks on aws_instance resources.
+// They are only checked if declared, and are merged into the default tags of the provider like the tags attribute.
+func (r *AwsResourceMissingTagsRule) checkAwsInstanceVolumes(runner tflint.Runner, config awsResourceTagsRuleConfig, providerTagsMap map[string]*tagSet) error {
+	resourceType := "aws_instance"
 
-	resources, err := runner.GetResourceContent("aws_autoscaling_group", &hclext.BodySchema{
+	if stringInSlice(resourceType, config.Exclude) {
+		return nil
+	}
+
+	deviceSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: tagsAttributeName}},
+	}
+	resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
-			{Name: tagsAttributeName},
+			{Name: "volume_tags"},
+			{Name: providerAttributeName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{Type: "root_block_device", Body: deviceSchema},
+			{Type: "ebs_block_device", Body: deviceSchema},
 		},
 	}, nil)
 	if err != nil {
-		return tags, hcl.Range{}, err
+		return err
 	}
 
 	for _, resource := range resources.Blocks {
-		if resource.Labels[0] != resourceBlock.Labels[0] {
-			continue
+		providerTags, err := resourceProviderTags(resource, providerTagsMap)
+		if err != nil {
+			return err
 		}
 
-		attribute, ok := resource.Body.Attributes[tagsAttributeName]
-		if ok {
-			wantType := cty.List(cty.Object(map[string]cty.Type{
-				"key":                 cty.String,
-				"value":               cty.String,
-				"propagate_at_launch": cty.Bool,
-			}))
-			err := runner.EvaluateExpr(attribute.Expr, func(asgTags []awsAutoscalingGroupTag) error {
-				for _, tag := range asgTags {
-					tags[tag.Key] = tag.Value
-				}
-				return nil
-			}, &tflint.EvaluateExprOption{WantType: &wantType})
+		attributes := []*hclext.Attribute{}
+		if attribute, exists := resource.Body.Attributes["volume_tags"]; exists {
+			attributes = append(attributes, attribute)
+		}
+		for _, block := range resource.Body.Blocks {
+			if attribute, exists := block.Body.Attributes[tagsAttributeName]; exists {
+				attributes = append(attributes, attribute)
+			}
+		}
+
+		for _, attribute := range attributes {
+			volumeTags := newTagSet()
+			volumeTags.merge(providerTags)
+
+			evaluatedTags, err := evaluateTags(runner, attribute.Expr)
 			if err != nil {
-				return tags, attribute.Expr.Range(), err
+				return err
 			}
-			return tags, attribute.Expr.Range(), nil
+			volumeTags.merge(evaluatedTags)
+
+			r.emitIssue(runner, resourceType, volumeTags, config, attribute.Expr.Range())
 		}
 	}
 
-	return tags, resourceBlock.DefRange, nil
+	return nil
 }
 
-func (r *AwsResourceMissingTagsRule) emitIssue(runner tflint.Runner, tags map[string]string, config awsResourceTagsRuleConfig, location hcl.Range) {
+func (r *AwsResourceMissingTagsRule) emitIssue(runner tflint.Runner, resourceType string, tags *tagSet, config awsResourceTagsRuleConfig, location hcl.Range) {
 	var missing []string
-	for _, tag := range config.Tags {
-		if _, ok := tags[tag]; !ok {
+	for _, tag := range config.requiredTags(resourceType) {
+		if !tags.has(tag) {
 			missing = append(missing, fmt.Sprintf("\"%s\"", tag))
 		}
 	}
@@ -394,15 +410,33 @@ func (r *AwsResourceMissingTagsRule) emitIssue(runner tflint.Runner, tags map[st
 		sort.Strings(missing)
 		wanted := strings.Join(missing, ", ")
 		issue := fmt.Sprintf("The resource is missing the following tags: %s.", wanted)
+		if tags.unknown {
+			// Tags from module outputs and unexpanded dynamic blocks cannot be evaluated
+			issue = fmt.Sprintf("The tags cannot be evaluated, so the resource may be missing the following tags: %s.", wanted)
+		}
 		runner.EmitIssue(r, issue, location)
 	}
-}
 
-func stringInSlice(a string, list []string) bool {
-	for _, b := range list {
-		if b == a {
-			return true
+	for _, constraint := range config.Tag {
+		// Constraints are not checked for tags that are not declared or whose values are unknown
+		value, ok := tags.value(constraint.Key)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case constraint.NonEmpty && value == "":
+			runner.EmitIssue(r, fmt.Sprintf("The tag \"%s\" must not be empty.", constraint.Key), location)
+		case len(constraint.Values) > 0 && !stringInSlice(value, constraint.Values):
+			allowed := make([]string, len(constraint.Values))
+			for i, v := range constraint.Values {
+				allowed[i] = fmt.Sprintf("\"%s\"", v)
+			}
+			issue := fmt.Sprintf("The tag \"%s\" has the value \"%s\", but must be one of %s.", constraint.Key, value, strings.Join(allowed, ", "))
+			runner.EmitIssue(r, issue, location)
+		case constraint.pattern != nil && !constraint.pattern.MatchString(value):
+			issue := fmt.Sprintf("The tag \"%s\" has the value \"%s\", but must match /%s/.", constraint.Key, value, constraint.Pattern)
+			runner.EmitIssue(r, issue, location)
 		}
 	}
-	return false
 }
@@ -1,7 +1,6 @@
 package rules
 
 import (
-	"errors"
 	"testing"
 
 	hcl "github.com/hashicorp/hcl/v2"
@@ -384,7 +383,7 @@ rule "aws_resource_missing_tags" {
 			},
 		},
 		{
-			Name: "Provider reference no existent",
+			Name: "Provider reference not declared in the module",
 			Content: `provider "aws" {
   alias = "zoom"
   default_tags {
@@ -403,8 +402,17 @@ rule "aws_resource_missing_tags" {
   enabled = true
   tags = ["Foo"]
 }`,
-			Expected: helper.Issues{},
-			RaiseErr: errors.New("The aws provider with alias \"west\" doesn't exist."),
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The tags cannot be evaluated, so the resource may be missing the following tags: \"Foo\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 39},
+					},
+				},
+			},
 		},
 		{
 			Name: "Provider reference existent without tags definition",
@@ -472,7 +480,44 @@ rule "aws_resource_missing_tags" {
   enabled = true
   tags = ["Owner"]
 }`,
-			Expected: helper.Issues{},
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The tags cannot be evaluated, so the resource may be missing the following tags: \"Owner\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 23, Column: 10},
+						End:      hcl.Pos{Line: 23, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "Value maps from module outputs may be missing tags",
+			Content: `module "tags" {
+  source = "./tags"
+}
+
+resource "aws_s3_bucket" "a" {
+  name = "a"
+  tags = module.tags.tags
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+  tags = ["Owner"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The tags cannot be evaluated, so the resource may be missing the following tags: \"Owner\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 7, Column: 10},
+						End:      hcl.Pos{Line: 7, Column: 26},
+					},
+				},
+			},
 		},
 		{
 			Name: "Not wholly known tags as value maps should work as long as each key is statically defined",
@@ -514,13 +559,385 @@ rule "aws_resource_missing_tags" {
 }`,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "Tag value is not in the allowed values",
+			Content: `
+resource "aws_instance" "ec2_instance" {
+  instance_type = "t2.micro"
+  tags = {
+    Environment = "staging"
+  }
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+  tags = ["Environment"]
+
+  tag "Environment" {
+    values = ["dev", "prod"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The tag \"Environment\" has the value \"staging\", but must be one of \"dev\", \"prod\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 6, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "Tag values do not match patterns or are empty",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+  default_tags {
+    tags = {
+      Owner = "nobody"
+    }
+  }
+}
+
+resource "aws_s3_bucket" "bucket" {
+  tags = {
+    CostCenter = ""
+  }
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+
+  tag "CostCenter" {
+    non_empty = true
+  }
+
+  tag "Owner" {
+    pattern = "^[a-z.]+@example\\.com$"
+  }
+
+  tag "Project" {
+    values = ["foo"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The tag \"CostCenter\" must not be empty.",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 12, Column: 10},
+						End:      hcl.Pos{Line: 14, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The tag \"Owner\" has the value \"nobody\", but must match /^[a-z.]+@example\\.com$/.",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 12, Column: 10},
+						End:      hcl.Pos{Line: 14, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "Required tags are overridden for a resource type",
+			Content: `
+resource "aws_instance" "ec2_instance" {
+  instance_type = "t2.micro"
+  tags = {
+    Owner = "alice"
+  }
+}
+
+resource "aws_s3_bucket" "bucket" {
+  tags = {
+    Foo = "bar"
+  }
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+  tags = ["Foo"]
+
+  resource "aws_s3_bucket" {
+    tags = ["Owner"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The resource is missing the following tags: \"Foo\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 6, Column: 4},
+					},
+				},
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The resource is missing the following tags: \"Owner\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 10, Column: 10},
+						End:      hcl.Pos{Line: 12, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "Tag value of autoscaling group tag blocks",
+			Content: `
+resource "aws_autoscaling_group" "asg" {
+  tag {
+    key                 = "Environment"
+    value               = "staging"
+    propagate_at_launch = true
+  }
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+
+  tag "Environment" {
+    values = ["dev", "prod"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The tag \"Environment\" has the value \"staging\", but must be one of \"dev\", \"prod\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "Sensitive autoscaling group tag blocks",
+			Content: `
+variable "key" {
+  default   = "Environment"
+  sensitive = true
+}
+
+variable "value" {
+  default   = "staging"
+  sensitive = true
+}
+
+resource "aws_autoscaling_group" "asg" {
+  tag {
+    key                 = var.key
+    value               = var.value
+    propagate_at_launch = true
+  }
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+
+  tag "Environment" {
+    values = ["dev", "prod"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The tag \"Environment\" has the value \"staging\", but must be one of \"dev\", \"prod\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 12, Column: 1},
+						End:      hcl.Pos{Line: 12, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "Tag blocks are checked for each autoscaling group",
+			Content: `
+resource "aws_autoscaling_group" "a" {
+  tag {
+    key                 = "Foo"
+    value               = "bar"
+    propagate_at_launch = true
+  }
+}
+
+resource "aws_autoscaling_group" "b" {
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+  tags = ["Foo"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The resource is missing the following tags: \"Foo\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "Dynamic tag blocks cannot be evaluated",
+			Content: `
+variable "tags" {
+  default = [{ key = "Foo", value = "bar" }]
+}
+
+resource "aws_autoscaling_group" "asg" {
+  tag {
+    key                 = "Bar"
+    value               = "baz"
+    propagate_at_launch = true
+  }
+
+  dynamic "tag" {
+    for_each = var.tags
+
+    content {
+      key                 = tag.value.key
+      value               = tag.value.value
+      propagate_at_launch = true
+    }
+  }
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+  tags = ["Bar", "Foo"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The tags cannot be evaluated, so the resource may be missing the following tags: \"Foo\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "Tag specifications of launch templates",
+			Content: `
+provider "aws" {
+  default_tags {
+    tags = {
+      Owner = "platform"
+    }
+  }
+}
+
+resource "aws_launch_template" "lt" {
+  tags = {
+    Foo = "bar"
+  }
+
+  tag_specifications {
+    resource_type = "instance"
+    tags = {
+      Foo = "bar"
+    }
+  }
+
+  tag_specifications {
+    resource_type = "volume"
+  }
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+  tags = ["Foo", "Owner"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The resource is missing the following tags: \"Owner\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 17, Column: 12},
+						End:      hcl.Pos{Line: 19, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The resource is missing the following tags: \"Foo\", \"Owner\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 22, Column: 3},
+						End:      hcl.Pos{Line: 22, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "Volume tags of instances",
+			Content: `
+provider "aws" {
+  default_tags {
+    tags = {
+      Owner = "platform"
+    }
+  }
+}
+
+resource "aws_instance" "a" {
+  tags = {
+    Foo = "bar"
+  }
+
+  volume_tags = {
+    Bar = "baz"
+  }
+}
+
+resource "aws_instance" "b" {
+  tags = {
+    Foo = "bar"
+  }
+
+  root_block_device {
+    tags = {
+      Foo = "bar"
+    }
+  }
+}`,
+			Config: `
+rule "aws_resource_missing_tags" {
+  enabled = true
+  tags = ["Foo", "Owner"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceMissingTagsRule(),
+					Message: "The resource is missing the following tags: \"Foo\".",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 15, Column: 17},
+						End:      hcl.Pos{Line: 17, Column: 4},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAwsResourceMissingTagsRule()
 
 	for _, tc := range cases {
 		t.Run(tc.Name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"module.tf": tc.Content, ".tflint.hcl": tc.Config})
+			runner := newUnknownModuleRunner(t, map[string]string{"module.tf": tc.Content, ".tflint.hcl": tc.Config})
 
 			err := rule.Check(runner)
 
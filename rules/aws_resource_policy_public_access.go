@@ -0,0 +1,174 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"github.com/zclconf/go-cty/cty"
+	"golang.org/x/exp/maps"
+)
+
+// AwsResourcePolicyPublicAccessRule checks whether resource-based policies grant access to everyone without a restricting condition
+type AwsResourcePolicyPublicAccessRule struct {
+	tflint.DefaultRule
+
+	// policyResources maps resource types to the attribute that holds the JSON resource policy
+	policyResources map[string]string
+	// restrictingConditionKeys are condition keys that limit who can use a public statement (lowercase)
+	restrictingConditionKeys map[string]bool
+}
+
+// NewAwsResourcePolicyPublicAccessRule returns new rule with default attributes
+func NewAwsResourcePolicyPublicAccessRule() *AwsResourcePolicyPublicAccessRule {
+	return &AwsResourcePolicyPublicAccessRule{
+		policyResources: map[string]string{
+			"aws_s3_bucket_policy":      "policy",
+			"aws_sqs_queue_policy":      "policy",
+			"aws_sns_topic_policy":      "policy",
+			"aws_kms_key":               "policy",
+			"aws_ecr_repository_policy": "policy",
+		},
+		restrictingConditionKeys: map[string]bool{
+			"aws:sourcevpce":        true,
+			"aws:sourcevpc":         true,
+			"aws:sourceip":          true,
+			"aws:sourcearn":         true,
+			"aws:sourceaccount":     true,
+			"aws:sourceowner":       true,
+			"aws:principalorgid":    true,
+			"aws:principalorgpaths": true,
+			"aws:principalaccount":  true,
+			"aws:principalarn":      true,
+			"kms:calleraccount":     true,
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsResourcePolicyPublicAccessRule) Name() string {
+	return "aws_resource_policy_public_access"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsResourcePolicyPublicAccessRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsResourcePolicyPublicAccessRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsResourcePolicyPublicAccessRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether resource policies and Lambda permissions allow the "*" principal without a restricting condition
+func (r *AwsResourcePolicyPublicAccessRule) Check(runner tflint.Runner) error {
+	documents, err := renderIAMPolicyDocumentDataSources(runner)
+	if err != nil {
+		return err
+	}
+
+	resourceTypes := maps.Keys(r.policyResources)
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		err := walkIAMPolicyAttribute(runner, resourceType, r.policyResources[resourceType], documents, func(statement *iamPolicyStatement) error {
+			if !statement.IsAllow() || !r.isPublic(statement.Principal) || r.isRestricted(statement) {
+				return nil
+			}
+
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf(`The %s policy grants access to everyone ("*") without a condition such as aws:SourceVpce, aws:PrincipalOrgID or aws:SourceArn.`, resourceType),
+				statement.Range,
+			)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.checkLambdaPermissions(runner)
+}
+
+// checkLambdaPermissions checks aws_lambda_permission, which declares a single statement with attributes instead of a JSON policy
+func (r *AwsResourcePolicyPublicAccessRule) checkLambdaPermissions(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("aws_lambda_permission", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "principal"},
+			{Name: "source_arn"},
+			{Name: "source_account"},
+			{Name: "principal_org_id"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes["principal"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(principal string) error {
+			if principal != "*" {
+				return nil
+			}
+
+			// Values that cannot be evaluated are considered to restrict the principal
+			restricted := false
+			for _, name := range []string{"source_arn", "source_account", "principal_org_id"} {
+				restriction, exists := resource.Body.Attributes[name]
+				if !exists {
+					continue
+				}
+				err := runner.EvaluateExpr(restriction.Expr, func(val cty.Value) error {
+					restricted = restricted || !val.IsKnown() || !val.IsNull()
+					return nil
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+			if restricted {
+				return nil
+			}
+
+			return runner.EmitIssue(
+				r,
+				"The Lambda permission grants access to everyone (\"*\") without source_arn, source_account or principal_org_id.",
+				attribute.Expr.Range(),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isPublic returns whether the principal includes everyone
+func (r *AwsResourcePolicyPublicAccessRule) isPublic(principal iamPolicyPrincipal) bool {
+	return principal["*"].Contains("*") || principal["AWS"].Contains("*")
+}
+
+// isRestricted returns whether the statement has a condition that limits the principals
+func (r *AwsResourcePolicyPublicAccessRule) isRestricted(statement *iamPolicyStatement) bool {
+	for _, condition := range statement.Condition {
+		for key := range condition {
+			if r.restrictingConditionKeys[strings.ToLower(key)] {
+				return true
+			}
+		}
+	}
+	return false
+}
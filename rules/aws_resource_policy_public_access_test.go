@@ -0,0 +1,238 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsResourcePolicyPublicAccess(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public bucket policy without condition",
+			Content: `
+resource "aws_s3_bucket_policy" "policy" {
+  bucket = "test_bucket"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::test_bucket/*"
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourcePolicyPublicAccessRule(),
+					Message: `The aws_s3_bucket_policy policy grants access to everyone ("*") without a condition such as aws:SourceVpce, aws:PrincipalOrgID or aws:SourceArn.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 16, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "public queue policy with AWS wildcard",
+			Content: `
+resource "aws_sqs_queue_policy" "policy" {
+  queue_url = "https://sqs.us-east-1.amazonaws.com/111111111111/test"
+  policy    = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {"AWS": ["*"]},
+    "Action": "sqs:SendMessage",
+    "Resource": "*",
+    "Condition": {"Bool": {"aws:SecureTransport": "true"}}
+  }
+}
+EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourcePolicyPublicAccessRule(),
+					Message: `The aws_sqs_queue_policy policy grants access to everyone ("*") without a condition such as aws:SourceVpce, aws:PrincipalOrgID or aws:SourceArn.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 15},
+						End:      hcl.Pos{Line: 15, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "public bucket policy in policy document",
+			Content: `
+data "aws_iam_policy_document" "bucket" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::test_bucket/*"]
+
+    principals {
+      type        = "*"
+      identifiers = ["*"]
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy" "policy" {
+  bucket = "test_bucket"
+  policy = data.aws_iam_policy_document.bucket.json
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourcePolicyPublicAccessRule(),
+					Message: `The aws_s3_bucket_policy policy grants access to everyone ("*") without a condition such as aws:SourceVpce, aws:PrincipalOrgID or aws:SourceArn.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 16, Column: 12},
+						End:      hcl.Pos{Line: 16, Column: 52},
+					},
+				},
+			},
+		},
+		{
+			Name: "restricted policies",
+			Content: `
+resource "aws_sns_topic_policy" "policy" {
+  arn    = "arn:aws:sns:us-east-1:111111111111:test"
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "sns:Publish",
+      "Resource": "*",
+      "Condition": {"ArnLike": {"aws:SourceArn": "arn:aws:s3:::test_bucket"}}
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_kms_key" "key" {
+  policy = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "*"},
+      "Action": "kms:Decrypt",
+      "Resource": "*",
+      "Condition": {"StringEquals": {"aws:PrincipalOrgID": "o-123456"}}
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_ecr_repository_policy" "policy" {
+  repository = "test"
+  policy     = <<-EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::111111111111:root"},
+      "Action": "ecr:BatchGetImage"
+    }
+  ]
+}
+EOF
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "public lambda permission",
+			Content: `
+resource "aws_lambda_permission" "public" {
+  action        = "lambda:InvokeFunction"
+  function_name = "test"
+  principal     = "*"
+}
+
+resource "aws_lambda_permission" "restricted" {
+  action        = "lambda:InvokeFunction"
+  function_name = "test"
+  principal     = "*"
+  source_arn    = "arn:aws:execute-api:us-east-1:111111111111:abcdef/*"
+}
+
+resource "aws_lambda_permission" "service" {
+  action        = "lambda:InvokeFunction"
+  function_name = "test"
+  principal     = "s3.amazonaws.com"
+}
+
+resource "aws_lambda_permission" "null_source" {
+  action         = "lambda:InvokeFunction"
+  function_name  = "test"
+  principal      = "*"
+  source_account = null
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourcePolicyPublicAccessRule(),
+					Message: `The Lambda permission grants access to everyone ("*") without source_arn, source_account or principal_org_id.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 19},
+						End:      hcl.Pos{Line: 5, Column: 22},
+					},
+				},
+				{
+					Rule:    NewAwsResourcePolicyPublicAccessRule(),
+					Message: `The Lambda permission grants access to everyone ("*") without source_arn, source_account or principal_org_id.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 24, Column: 20},
+						End:      hcl.Pos{Line: 24, Column: 23},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsResourcePolicyPublicAccessRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"github.com/terraform-linters/tflint-ruleset-aws/rules/tags"
+	"github.com/zclconf/go-cty/cty"
+	"golang.org/x/exp/maps"
+)
+
+// AwsResourceTagKeyConventionRule checks whether tag keys follow a naming convention
+type AwsResourceTagKeyConventionRule struct {
+	tflint.DefaultRule
+}
+
+type awsResourceTagKeyConventionConfig struct {
+	Style      string   `hclext:"style,optional"`
+	Format     string   `hclext:"format,optional"`
+	IgnoreKeys []string `hclext:"ignore_keys,optional"`
+}
+
+// tagKeyStyle is a preset naming convention of tag keys
+type tagKeyStyle struct {
+	pattern *regexp.Regexp
+	// convert joins the words of a key in the style
+	convert func(words []string) string
+}
+
+var tagKeyStyles = map[string]tagKeyStyle{
+	"PascalCase": {
+		pattern: regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+		convert: func(words []string) string {
+			for i, word := range words {
+				words[i] = titleWord(word)
+			}
+			return strings.Join(words, "")
+		},
+	},
+	"camelCase": {
+		pattern: regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+		convert: func(words []string) string {
+			for i, word := range words {
+				if i == 0 {
+					words[i] = strings.ToLower(word)
+				} else {
+					words[i] = titleWord(word)
+				}
+			}
+			return strings.Join(words, "")
+		},
+	},
+	"kebab-case": {
+		pattern: regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`),
+		convert: func(words []string) string {
+			return strings.ToLower(strings.Join(words, "-"))
+		},
+	},
+	"snake_case": {
+		pattern: regexp.MustCompile(`^[a-z0-9]+(?:_[a-z0-9]+)*$`),
+		convert: func(words []string) string {
+			return strings.ToLower(strings.Join(words, "_"))
+		},
+	},
+}
+
+// NewAwsResourceTagKeyConventionRule returns new rule with default attributes
+func NewAwsResourceTagKeyConventionRule() *AwsResourceTagKeyConventionRule {
+	return &AwsResourceTagKeyConventionRule{}
+}
+
+// Name returns the rule name
+func (r *AwsResourceTagKeyConventionRule) Name() string {
+	return "aws_resource_tag_key_convention"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsResourceTagKeyConventionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsResourceTagKeyConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AwsResourceTagKeyConventionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// tagKeyConvention checks tag keys against a preset style or a custom format
+type tagKeyConvention struct {
+	name       string
+	pattern    *regexp.Regexp
+	convert    func(words []string) string
+	ignoreKeys []string
+}
+
+// Check checks the keys of tags attributes, default_tags of providers and tag blocks of aws_autoscaling_group
+func (r *AwsResourceTagKeyConventionRule) Check(runner tflint.Runner) error {
+	config := awsResourceTagKeyConventionConfig{Style: "PascalCase"}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	convention := &tagKeyConvention{ignoreKeys: config.IgnoreKeys}
+	if config.Format != "" {
+		pattern, err := regexp.Compile(config.Format)
+		if err != nil {
+			return fmt.Errorf("invalid format: %w", err)
+		}
+		convention.name = fmt.Sprintf("/%s/", config.Format)
+		convention.pattern = pattern
+	} else {
+		style, exists := tagKeyStyles[config.Style]
+		if !exists {
+			styles := maps.Keys(tagKeyStyles)
+			sort.Strings(styles)
+			return fmt.Errorf(`invalid style "%s", must be one of %s`, config.Style, strings.Join(styles, ", "))
+		}
+		convention.name = config.Style
+		convention.pattern = style.pattern
+		convention.convert = style.convert
+	}
+
+	providers, err := runner.GetProviderContent("aws", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: defaultTagsBlockName,
+				Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: tagsAttributeName}}},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	for _, provider := range providers.Blocks {
+		for _, block := range provider.Body.Blocks {
+			if err := r.checkTagsAttribute(runner, convention, block.Body); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, resourceType := range tags.Resources {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: tagsAttributeName}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			if err := r.checkTagsAttribute(runner, convention, resource.Body); err != nil {
+				return err
+			}
+		}
+	}
+
+	resources, err := runner.GetResourceContent("aws_autoscaling_group", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: tagBlockName,
+				Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "key"}}},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	for _, resource := range resources.Blocks {
+		for _, tag := range resource.Body.Blocks {
+			attribute, exists := tag.Body.Attributes["key"]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(key string) error {
+				return r.checkKey(runner, convention, key, attribute.Expr.Range())
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkTagsAttribute checks the keys of the tags attribute in the body.
+// Keys of object constructors are reported at the key, and others are reported at the attribute.
+func (r *AwsResourceTagKeyConventionRule) checkTagsAttribute(runner tflint.Runner, convention *tagKeyConvention, body *hclext.BodyContent) error {
+	attribute, exists := body.Attributes[tagsAttributeName]
+	if !exists {
+		return nil
+	}
+
+	keyRanges := map[string]hcl.Range{}
+	if pairs, diags := hcl.ExprMap(attribute.Expr); !diags.HasErrors() {
+		for _, pair := range pairs {
+			key, diags := pair.Key.Value(nil)
+			if diags.HasErrors() || !key.IsKnown() || key.IsNull() || key.Type() != cty.String {
+				continue
+			}
+			keyRanges[key.AsString()] = pair.Key.Range()
+		}
+	}
+
+	return runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
+		// Only the keys are reported, so sensitive tags are checked as well
+		val, _ = val.UnmarkDeep()
+		if !val.IsKnown() || val.IsNull() || !(val.Type().IsMapType() || val.Type().IsObjectType()) {
+			return nil
+		}
+
+		keys := maps.Keys(val.AsValueMap())
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			location, exists := keyRanges[key]
+			if !exists {
+				location = attribute.Expr.Range()
+			}
+			if err := r.checkKey(runner, convention, key, location); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil)
+}
+
+func (r *AwsResourceTagKeyConventionRule) checkKey(runner tflint.Runner, convention *tagKeyConvention, key string, location hcl.Range) error {
+	if convention.pattern.MatchString(key) || stringInSlice(key, convention.ignoreKeys) {
+		return nil
+	}
+
+	message := fmt.Sprintf(`The tag key "%s" does not follow %s.`, key, convention.name)
+	if convention.convert != nil {
+		if words := tagKeyWords(key); len(words) > 0 {
+			if suggestion := convention.convert(words); convention.pattern.MatchString(suggestion) {
+				message += fmt.Sprintf(` Consider renaming it to "%s".`, suggestion)
+			}
+		}
+	}
+
+	return runner.EmitIssue(r, message, location)
+}
+
+// tagKeyWords splits a tag key into words by separators and case boundaries, such as "costCenter" and "HTTPServer"
+func tagKeyWords(key string) []string {
+	words := []string{}
+	current := []rune{}
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = []rune{}
+		}
+	}
+
+	runes := []rune(key)
+	for i, c := range runes {
+		switch {
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			flush()
+		case unicode.IsUpper(c) && len(current) > 0 && (!unicode.IsUpper(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))):
+			flush()
+			current = append(current, c)
+		default:
+			current = append(current, c)
+		}
+	}
+	flush()
+
+	return words
+}
+
+// titleWord upper-cases the first letter of the word and lower-cases the rest
+func titleWord(word string) string {
+	runes := []rune(strings.ToLower(word))
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,206 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsResourceTagKeyConvention(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "PascalCase by default",
+			Content: `
+resource "aws_instance" "main" {
+  tags = {
+    Name          = "main"
+    "cost-center" = "1234"
+    ownerEmail    = "team@example.com"
+  }
+}
+`,
+			Config: `
+rule "aws_resource_tag_key_convention" {
+  enabled = true
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceTagKeyConventionRule(),
+					Message: `The tag key "cost-center" does not follow PascalCase. Consider renaming it to "CostCenter".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 5},
+						End:      hcl.Pos{Line: 5, Column: 18},
+					},
+				},
+				{
+					Rule:    NewAwsResourceTagKeyConventionRule(),
+					Message: `The tag key "ownerEmail" does not follow PascalCase. Consider renaming it to "OwnerEmail".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			Name: "kebab-case with default tags and autoscaling group tag blocks",
+			Content: `
+provider "aws" {
+  default_tags {
+    tags = {
+      CostCenter = "1234"
+    }
+  }
+}
+
+resource "aws_autoscaling_group" "main" {
+  tag {
+    key                 = "HTTPServer"
+    value               = "nginx"
+    propagate_at_launch = true
+  }
+}
+`,
+			Config: `
+rule "aws_resource_tag_key_convention" {
+  enabled = true
+  style   = "kebab-case"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceTagKeyConventionRule(),
+					Message: `The tag key "CostCenter" does not follow kebab-case. Consider renaming it to "cost-center".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 7},
+						End:      hcl.Pos{Line: 5, Column: 17},
+					},
+				},
+				{
+					Rule:    NewAwsResourceTagKeyConventionRule(),
+					Message: `The tag key "HTTPServer" does not follow kebab-case. Consider renaming it to "http-server".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 27},
+						End:      hcl.Pos{Line: 12, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "custom format and ignored keys",
+			Content: `
+resource "aws_s3_bucket" "main" {
+  tags = {
+    Name           = "main"
+    "team:owner"   = "platform"
+    "owner"        = "platform"
+  }
+}
+`,
+			Config: `
+rule "aws_resource_tag_key_convention" {
+  enabled     = true
+  format      = "^[a-z]+:[a-z]+$"
+  ignore_keys = ["Name"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceTagKeyConventionRule(),
+					Message: `The tag key "owner" does not follow /^[a-z]+:[a-z]+$/.`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "keys of non-literal maps",
+			Content: `
+variable "tags" {
+  default = {
+    cost_center = "1234"
+  }
+}
+
+resource "aws_s3_bucket" "main" {
+  tags = var.tags
+}
+`,
+			Config: `
+rule "aws_resource_tag_key_convention" {
+  enabled = true
+  style   = "camelCase"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceTagKeyConventionRule(),
+					Message: `The tag key "cost_center" does not follow camelCase. Consider renaming it to "costCenter".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 10},
+						End:      hcl.Pos{Line: 9, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "sensitive tags",
+			Content: `
+variable "tags" {
+  default = {
+    cost_center = "1234"
+  }
+  sensitive = true
+}
+
+resource "aws_s3_bucket" "main" {
+  tags = var.tags
+}
+`,
+			Config: `
+rule "aws_resource_tag_key_convention" {
+  enabled = true
+  style   = "camelCase"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsResourceTagKeyConventionRule(),
+					Message: `The tag key "cost_center" does not follow camelCase. Consider renaming it to "costCenter".`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 10},
+						End:      hcl.Pos{Line: 10, Column: 18},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsResourceTagKeyConventionRule()
+
+	for _, tc := range cases {
+		runner := newUnknownModuleRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
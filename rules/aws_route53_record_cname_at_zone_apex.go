@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsRoute53RecordCnameAtZoneApexRule checks whether CNAME records are created at the zone apex
+type AwsRoute53RecordCnameAtZoneApexRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAwsRoute53RecordCnameAtZoneApexRule returns new rule with default attributes
+func NewAwsRoute53RecordCnameAtZoneApexRule() *AwsRoute53RecordCnameAtZoneApexRule {
+	return &AwsRoute53RecordCnameAtZoneApexRule{
+		resourceType: "aws_route53_record",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsRoute53RecordCnameAtZoneApexRule) Name() string {
+	return "aws_route53_record_cname_at_zone_apex"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsRoute53RecordCnameAtZoneApexRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsRoute53RecordCnameAtZoneApexRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsRoute53RecordCnameAtZoneApexRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether the name of CNAME records is the name of the hosted zone.
+// Only records in hosted zones declared in the module are checked.
+func (r *AwsRoute53RecordCnameAtZoneApexRule) Check(runner tflint.Runner) error {
+	zones, err := newRoute53Zones(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, route53RecordSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		typeAttr, exists := resource.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+		nameAttr, exists := resource.Body.Attributes["name"]
+		if !exists {
+			continue
+		}
+
+		_, zoneName, err := zones.zone(runner, resource)
+		if err != nil {
+			return err
+		}
+		if zoneName == "" {
+			continue
+		}
+
+		recordType := ""
+		if err := runner.EvaluateExpr(typeAttr.Expr, func(val string) error {
+			recordType = val
+			return nil
+		}, nil); err != nil {
+			return err
+		}
+		if recordType != "CNAME" {
+			continue
+		}
+
+		err = runner.EvaluateExpr(nameAttr.Expr, func(name string) error {
+			if zones.recordName(name, zoneName) != zoneName {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf(`CNAME record cannot be created at the zone apex "%s". Use an alias record instead`, zoneName),
+				nameAttr.Expr.Range(),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
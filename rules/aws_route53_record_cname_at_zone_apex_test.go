@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsRoute53RecordCnameAtZoneApex(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "CNAME at zone apex",
+			Content: `
+resource "aws_route53_zone" "main" {
+  name = "Example.com."
+}
+
+resource "aws_route53_record" "apex" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "example.com"
+  type    = "CNAME"
+  ttl     = 300
+  records = ["lb.example.net"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRoute53RecordCnameAtZoneApexRule(),
+					Message: `CNAME record cannot be created at the zone apex "example.com". Use an alias record instead`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 13},
+						End:      hcl.Pos{Line: 8, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_route53_zone" "main" {
+  name = "example.com"
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "www"
+  type    = "CNAME"
+  ttl     = 300
+  records = ["lb.example.net"]
+}
+
+resource "aws_route53_record" "apex" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "example.com"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_record" "external" {
+  zone_id = "Z0123456789"
+  name    = "example.org"
+  type    = "CNAME"
+  ttl     = 300
+  records = ["lb.example.net"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsRoute53RecordCnameAtZoneApexRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsRoute53RecordDuplicateRule checks whether records with the same name, type and set_identifier are declared in the same hosted zone
+type AwsRoute53RecordDuplicateRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAwsRoute53RecordDuplicateRule returns new rule with default attributes
+func NewAwsRoute53RecordDuplicateRule() *AwsRoute53RecordDuplicateRule {
+	return &AwsRoute53RecordDuplicateRule{
+		resourceType: "aws_route53_record",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsRoute53RecordDuplicateRule) Name() string {
+	return "aws_route53_record_duplicate"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsRoute53RecordDuplicateRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsRoute53RecordDuplicateRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsRoute53RecordDuplicateRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each record is unique in the module.
+// Hosted zones are identified by resource addresses or literal zone IDs, and records with unknown values are ignored.
+func (r *AwsRoute53RecordDuplicateRule) Check(runner tflint.Runner) error {
+	zones, err := newRoute53Zones(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, route53RecordSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	// declared maps record keys to the first resource that declares them
+	declared := map[string]string{}
+
+	for _, resource := range resources.Blocks {
+		zone, zoneName, err := zones.zone(runner, resource)
+		if err != nil {
+			return err
+		}
+		if zone == "" {
+			continue
+		}
+
+		values := map[string]string{}
+		known := true
+		for _, name := range []string{"name", "type", "set_identifier"} {
+			attribute, exists := resource.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			evaluated := false
+			if err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+				values[name] = val
+				evaluated = true
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+			known = known && evaluated
+		}
+		if !known || values["name"] == "" || values["type"] == "" {
+			continue
+		}
+
+		recordName := zones.recordName(values["name"], zoneName)
+		key := fmt.Sprintf("%s/%s/%s/%s", zone, recordName, values["type"], values["set_identifier"])
+		address := fmt.Sprintf("%s.%s", r.resourceType, resource.Labels[1])
+
+		first, exists := declared[key]
+		if !exists {
+			declared[key] = address
+			continue
+		}
+
+		if err := r.emitDuplicate(runner, resource, recordName, values, first); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *AwsRoute53RecordDuplicateRule) emitDuplicate(runner tflint.Runner, resource *hclext.Block, recordName string, values map[string]string, first string) error {
+	record := fmt.Sprintf(`%s record "%s"`, values["type"], recordName)
+	if values["set_identifier"] != "" {
+		record = fmt.Sprintf(`%s with set_identifier "%s"`, record, values["set_identifier"])
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("The %s is already declared by %s", record, first),
+		resource.DefRange,
+	)
+}
@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsRoute53RecordDuplicate(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "duplicate records",
+			Content: `
+resource "aws_route53_zone" "main" {
+  name = "example.com"
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_record" "www_fqdn" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "WWW.example.com."
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.2"]
+}
+
+resource "aws_route53_record" "blue" {
+  zone_id        = "Z0123456789"
+  name           = "api.example.org"
+  type           = "A"
+  ttl            = 300
+  records        = ["192.0.2.3"]
+  set_identifier = "blue"
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+
+resource "aws_route53_record" "green" {
+  zone_id        = "Z0123456789"
+  name           = "api.example.org"
+  type           = "A"
+  ttl            = 300
+  records        = ["192.0.2.4"]
+  set_identifier = "blue"
+
+  weighted_routing_policy {
+    weight = 90
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRoute53RecordDuplicateRule(),
+					Message: `The A record "www.example.com" is already declared by aws_route53_record.www`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 1},
+						End:      hcl.Pos{Line: 14, Column: 41},
+					},
+				},
+				{
+					Rule:    NewAwsRoute53RecordDuplicateRule(),
+					Message: `The A record "api.example.org" with set_identifier "blue" is already declared by aws_route53_record.blue`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 35, Column: 1},
+						End:      hcl.Pos{Line: 35, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "unique records",
+			Content: `
+resource "aws_route53_record" "a" {
+  zone_id = "Z0123456789"
+  name    = "www.example.com"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_record" "aaaa" {
+  zone_id = "Z0123456789"
+  name    = "www.example.com"
+  type    = "AAAA"
+  ttl     = 300
+  records = ["2001:db8::1"]
+}
+
+resource "aws_route53_record" "other_zone" {
+  zone_id = "Z9876543210"
+  name    = "www.example.com"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsRoute53RecordDuplicateRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
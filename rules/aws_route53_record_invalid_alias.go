@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsRoute53RecordInvalidAliasRule checks whether alias records declare ttl or records
+type AwsRoute53RecordInvalidAliasRule struct {
+	tflint.DefaultRule
+
+	resourceType   string
+	attributeNames []string
+}
+
+// NewAwsRoute53RecordInvalidAliasRule returns new rule with default attributes
+func NewAwsRoute53RecordInvalidAliasRule() *AwsRoute53RecordInvalidAliasRule {
+	return &AwsRoute53RecordInvalidAliasRule{
+		resourceType:   "aws_route53_record",
+		attributeNames: []string{"ttl", "records"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsRoute53RecordInvalidAliasRule) Name() string {
+	return "aws_route53_record_invalid_alias"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsRoute53RecordInvalidAliasRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsRoute53RecordInvalidAliasRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsRoute53RecordInvalidAliasRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that ttl and records are not set with alias block.
+// The TTL of alias records is the TTL of the target.
+func (r *AwsRoute53RecordInvalidAliasRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, route53RecordSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if len(resource.Body.Blocks.OfType("alias")) == 0 {
+			continue
+		}
+
+		for _, name := range r.attributeNames {
+			set, err := isAttributeSet(runner, resource.Body, name)
+			if err != nil {
+				return err
+			}
+			if !set {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` cannot be set with `alias` block", name),
+				resource.Body.Attributes[name].Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
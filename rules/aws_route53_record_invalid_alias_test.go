@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsRoute53RecordInvalidAlias(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "alias with ttl and records",
+			Content: `
+resource "aws_route53_record" "www" {
+  zone_id = "Z0123456789"
+  name    = "www.example.com"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+
+  alias {
+    name                   = "lb.example.com"
+    zone_id                = "Z35SXDOTRQ7X7K"
+    evaluate_target_health = true
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRoute53RecordInvalidAliasRule(),
+					Message: "`ttl` cannot be set with `alias` block",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 13},
+						End:      hcl.Pos{Line: 6, Column: 16},
+					},
+				},
+				{
+					Rule:    NewAwsRoute53RecordInvalidAliasRule(),
+					Message: "`records` cannot be set with `alias` block",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 13},
+						End:      hcl.Pos{Line: 7, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_route53_record" "www" {
+  zone_id = "Z0123456789"
+  name    = "www.example.com"
+  type    = "A"
+
+  alias {
+    name                   = "lb.example.com"
+    zone_id                = "Z35SXDOTRQ7X7K"
+    evaluate_target_health = true
+  }
+}
+
+resource "aws_route53_record" "api" {
+  zone_id = "Z0123456789"
+  name    = "api.example.com"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsRoute53RecordInvalidAliasRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
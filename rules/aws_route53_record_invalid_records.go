@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsRoute53RecordInvalidRecordsRule checks whether values of TXT, MX and SRV records are well-formed
+type AwsRoute53RecordInvalidRecordsRule struct {
+	tflint.DefaultRule
+
+	resourceType       string
+	maxTXTStringLength int
+	mxPattern          *regexp.Regexp
+	srvPattern         *regexp.Regexp
+}
+
+// NewAwsRoute53RecordInvalidRecordsRule returns new rule with default attributes
+func NewAwsRoute53RecordInvalidRecordsRule() *AwsRoute53RecordInvalidRecordsRule {
+	return &AwsRoute53RecordInvalidRecordsRule{
+		resourceType:       "aws_route53_record",
+		maxTXTStringLength: 255,
+		mxPattern:          regexp.MustCompile(`^(\d+) +\S+$`),
+		srvPattern:         regexp.MustCompile(`^(\d+) +(\d+) +(\d+) +\S+$`),
+	}
+}
+
+// Name returns the rule name
+func (r *AwsRoute53RecordInvalidRecordsRule) Name() string {
+	return "aws_route53_record_invalid_records"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsRoute53RecordInvalidRecordsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsRoute53RecordInvalidRecordsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsRoute53RecordInvalidRecordsRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks the length of TXT strings and the format of MX and SRV values.
+// https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/ResourceRecordTypes.html
+func (r *AwsRoute53RecordInvalidRecordsRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, route53RecordSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		typeAttr, exists := resource.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+		recordsAttr, exists := resource.Body.Attributes["records"]
+		if !exists {
+			continue
+		}
+
+		recordType := ""
+		if err := runner.EvaluateExpr(typeAttr.Expr, func(val string) error {
+			recordType = val
+			return nil
+		}, nil); err != nil {
+			return err
+		}
+
+		err := runner.EvaluateExpr(recordsAttr.Expr, func(records []string) error {
+			for _, record := range records {
+				message := r.validate(recordType, record)
+				if message == "" {
+					continue
+				}
+				if err := runner.EmitIssue(r, message, recordsAttr.Expr.Range()); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate returns a message if the value is invalid for the record type
+func (r *AwsRoute53RecordInvalidRecordsRule) validate(recordType, record string) string {
+	switch recordType {
+	case "TXT", "SPF":
+		// Like the AWS provider, `""` splits a value into multiple strings
+		for _, str := range strings.Split(record, `""`) {
+			if len(str) > r.maxTXTStringLength {
+				return fmt.Sprintf(`A string of the %s record is %d characters and is limited to %d characters. Split it into multiple strings with "\"\""`, recordType, len(str), r.maxTXTStringLength)
+			}
+		}
+	case "MX":
+		if !r.validNumbers(r.mxPattern, record) {
+			return fmt.Sprintf(`"%s" is an invalid MX record. It must be "priority mail-server"`, record)
+		}
+	case "SRV":
+		if !r.validNumbers(r.srvPattern, record) {
+			return fmt.Sprintf(`"%s" is an invalid SRV record. It must be "priority weight port target"`, record)
+		}
+	}
+	return ""
+}
+
+// validNumbers returns whether the value matches the pattern and the captured numbers are 0 to 65535
+func (r *AwsRoute53RecordInvalidRecordsRule) validNumbers(pattern *regexp.Regexp, record string) bool {
+	match := pattern.FindStringSubmatch(record)
+	if match == nil {
+		return false
+	}
+	for _, number := range match[1:] {
+		if n, err := strconv.Atoi(number); err != nil || n > 65535 {
+			return false
+		}
+	}
+	return true
+}
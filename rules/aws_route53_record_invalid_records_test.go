@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsRoute53RecordInvalidRecords(t *testing.T) {
+	long := strings.Repeat("a", 256)
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "long TXT string",
+			Content: `
+resource "aws_route53_record" "dkim" {
+  zone_id = "Z0123456789"
+  name    = "mail._domainkey.example.com"
+  type    = "TXT"
+  ttl     = 300
+  records = ["` + long + `", "` + long[:255] + `\"\"` + long[:255] + `"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRoute53RecordInvalidRecordsRule(),
+					Message: `A string of the TXT record is 256 characters and is limited to 255 characters. Split it into multiple strings with "\"\""`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 13},
+						End:      hcl.Pos{Line: 7, Column: 791},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid MX and SRV",
+			Content: `
+resource "aws_route53_record" "mx" {
+  zone_id = "Z0123456789"
+  name    = "example.com"
+  type    = "MX"
+  ttl     = 300
+  records = ["mail.example.com", "10 mail.example.com"]
+}
+
+resource "aws_route53_record" "srv" {
+  zone_id = "Z0123456789"
+  name    = "_sip._tcp.example.com"
+  type    = "SRV"
+  ttl     = 300
+  records = ["10 5 70000 sip.example.com"]
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRoute53RecordInvalidRecordsRule(),
+					Message: `"mail.example.com" is an invalid MX record. It must be "priority mail-server"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 13},
+						End:      hcl.Pos{Line: 7, Column: 56},
+					},
+				},
+				{
+					Rule:    NewAwsRoute53RecordInvalidRecordsRule(),
+					Message: `"10 5 70000 sip.example.com" is an invalid SRV record. It must be "priority weight port target"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 13},
+						End:      hcl.Pos{Line: 15, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_route53_record" "mx" {
+  zone_id = "Z0123456789"
+  name    = "example.com"
+  type    = "MX"
+  ttl     = 300
+  records = ["10 mail1.example.com", "20 mail2.example.com."]
+}
+
+resource "aws_route53_record" "srv" {
+  zone_id = "Z0123456789"
+  name    = "_sip._tcp.example.com"
+  type    = "SRV"
+  ttl     = 300
+  records = ["10 5 5060 sip.example.com"]
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsRoute53RecordInvalidRecordsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsRoute53RecordInvalidRoutingPolicyRule checks whether routing policies and set_identifier are declared together
+type AwsRoute53RecordInvalidRoutingPolicyRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAwsRoute53RecordInvalidRoutingPolicyRule returns new rule with default attributes
+func NewAwsRoute53RecordInvalidRoutingPolicyRule() *AwsRoute53RecordInvalidRoutingPolicyRule {
+	return &AwsRoute53RecordInvalidRoutingPolicyRule{
+		resourceType: "aws_route53_record",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsRoute53RecordInvalidRoutingPolicyRule) Name() string {
+	return "aws_route53_record_invalid_routing_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsRoute53RecordInvalidRoutingPolicyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsRoute53RecordInvalidRoutingPolicyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsRoute53RecordInvalidRoutingPolicyRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that records with a routing policy have set_identifier, and set_identifier is only used with a routing policy
+func (r *AwsRoute53RecordInvalidRoutingPolicyRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, route53RecordSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		setIdentifier, err := isAttributeSet(runner, resource.Body, "set_identifier")
+		if err != nil {
+			return err
+		}
+
+		policies := []*hclext.Block{}
+		for _, name := range route53RoutingPolicyBlocks {
+			policies = append(policies, resource.Body.Blocks.OfType(name)...)
+		}
+
+		multivalueAnswer := false
+		if attribute, exists := resource.Body.Attributes["multivalue_answer_routing_policy"]; exists {
+			if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+				multivalueAnswer = val
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+		}
+
+		if setIdentifier {
+			if len(policies) > 0 || multivalueAnswer {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				"`set_identifier` can only be set with a routing policy",
+				resource.Body.Attributes["set_identifier"].Expr.Range(),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, policy := range policies {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("`set_identifier` should be set with `%s` block", policy.Type),
+				policy.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+		if multivalueAnswer {
+			if err := runner.EmitIssue(
+				r,
+				"`set_identifier` should be set with `multivalue_answer_routing_policy`",
+				resource.Body.Attributes["multivalue_answer_routing_policy"].Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
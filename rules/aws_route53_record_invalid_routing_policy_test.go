@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsRoute53RecordInvalidRoutingPolicy(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "weighted without set_identifier",
+			Content: `
+resource "aws_route53_record" "www" {
+  zone_id = "Z0123456789"
+  name    = "www.example.com"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRoute53RecordInvalidRoutingPolicyRule(),
+					Message: "`set_identifier` should be set with `weighted_routing_policy` block",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 3},
+						End:      hcl.Pos{Line: 9, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "set_identifier without routing policy",
+			Content: `
+resource "aws_route53_record" "www" {
+  zone_id        = "Z0123456789"
+  name           = "www.example.com"
+  type           = "A"
+  ttl            = 300
+  records        = ["192.0.2.1"]
+  set_identifier = "primary"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRoute53RecordInvalidRoutingPolicyRule(),
+					Message: "`set_identifier` can only be set with a routing policy",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 20},
+						End:      hcl.Pos{Line: 8, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_route53_record" "weighted" {
+  zone_id        = "Z0123456789"
+  name           = "www.example.com"
+  type           = "A"
+  ttl            = 300
+  records        = ["192.0.2.1"]
+  set_identifier = "blue"
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+
+resource "aws_route53_record" "multivalue" {
+  zone_id                          = "Z0123456789"
+  name                             = "api.example.com"
+  type                             = "A"
+  ttl                              = 300
+  records                          = ["192.0.2.1"]
+  set_identifier                   = "one"
+  multivalue_answer_routing_policy = true
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsRoute53RecordInvalidRoutingPolicyRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
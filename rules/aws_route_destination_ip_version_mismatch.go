@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsRouteDestinationIPVersionMismatchRule checks whether destinations of routes match the IP version of the attributes and targets
+type AwsRouteDestinationIPVersionMismatchRule struct {
+	tflint.DefaultRule
+
+	// ipv6Targets are targets that route only IPv6 traffic
+	ipv6Targets []string
+}
+
+// NewAwsRouteDestinationIPVersionMismatchRule returns new rule with default attributes
+func NewAwsRouteDestinationIPVersionMismatchRule() *AwsRouteDestinationIPVersionMismatchRule {
+	return &AwsRouteDestinationIPVersionMismatchRule{
+		ipv6Targets: []string{"egress_only_gateway_id"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsRouteDestinationIPVersionMismatchRule) Name() string {
+	return "aws_route_destination_ip_version_mismatch"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsRouteDestinationIPVersionMismatchRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsRouteDestinationIPVersionMismatchRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsRouteDestinationIPVersionMismatchRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks aws_route and inline route blocks.
+// IPv4 destinations must be IPv4 CIDR blocks, IPv6 destinations must be IPv6 CIDR blocks,
+// and IPv6-only targets must not be used with IPv4 destinations.
+func (r *AwsRouteDestinationIPVersionMismatchRule) Check(runner tflint.Runner) error {
+	return walkRoutes(runner, func(route *tableRoute) error {
+		for _, destination := range []struct {
+			name string
+			ipv6 bool
+		}{
+			{name: route.destinations.ipv4, ipv6: false},
+			{name: route.destinations.ipv6, ipv6: true},
+		} {
+			attribute, exists := route.body.Attributes[destination.name]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(cidr string) error {
+				prefix, err := netip.ParsePrefix(cidr)
+				// Malformed CIDR blocks are reported by other rules
+				if err != nil || prefix.Addr().Is6() == destination.ipv6 {
+					return nil
+				}
+
+				version := "IPv4"
+				if destination.ipv6 {
+					version = "IPv6"
+				}
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf(`%s must be an %s CIDR block, but "%s" is not`, destination.name, version, cidr),
+					attribute.Expr.Range(),
+				)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		ipv4Destination, err := isAttributeSet(runner, route.body, route.destinations.ipv4)
+		if err != nil {
+			return err
+		}
+		if !ipv4Destination {
+			return nil
+		}
+
+		for _, target := range r.ipv6Targets {
+			set, err := isAttributeSet(runner, route.body, target)
+			if err != nil {
+				return err
+			}
+			if !set {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s routes only IPv6 traffic. Use %s instead of %s", target, route.destinations.ipv6, route.destinations.ipv4),
+				route.body.Attributes[target].Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsRouteDestinationIPVersionMismatch(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "egress only gateway with IPv4 destination",
+			Content: `
+resource "aws_route" "foo" {
+  route_table_id         = "rtb-1234abcd"
+  destination_cidr_block = "0.0.0.0/0"
+  egress_only_gateway_id = "eigw-1234abcd"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRouteDestinationIPVersionMismatchRule(),
+					Message: "egress_only_gateway_id routes only IPv6 traffic. Use destination_ipv6_cidr_block instead of destination_cidr_block",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 28},
+						End:      hcl.Pos{Line: 5, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "egress only gateway with IPv6 destination",
+			Content: `
+resource "aws_route" "foo" {
+  route_table_id              = "rtb-1234abcd"
+  destination_ipv6_cidr_block = "::/0"
+  egress_only_gateway_id      = "eigw-1234abcd"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "IPv6 CIDR block in IPv4 destination",
+			Content: `
+resource "aws_route" "foo" {
+  route_table_id         = "rtb-1234abcd"
+  destination_cidr_block = "::/0"
+  gateway_id             = "igw-1234abcd"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRouteDestinationIPVersionMismatchRule(),
+					Message: `destination_cidr_block must be an IPv4 CIDR block, but "::/0" is not`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 28},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "inline routes",
+			Content: `
+resource "aws_route_table" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  route {
+    ipv6_cidr_block = "10.0.0.0/16"
+    gateway_id      = "igw-1234abcd"
+  }
+
+  route {
+    cidr_block             = "0.0.0.0/0"
+    egress_only_gateway_id = "eigw-1234abcd"
+  }
+
+  route {
+    cidr_block = "10.1.0.0/16"
+    gateway_id = "igw-1234abcd"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRouteDestinationIPVersionMismatchRule(),
+					Message: `ipv6_cidr_block must be an IPv6 CIDR block, but "10.0.0.0/16" is not`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 23},
+						End:      hcl.Pos{Line: 6, Column: 36},
+					},
+				},
+				{
+					Rule:    NewAwsRouteDestinationIPVersionMismatchRule(),
+					Message: "egress_only_gateway_id routes only IPv6 traffic. Use ipv6_cidr_block instead of cidr_block",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 30},
+						End:      hcl.Pos{Line: 12, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "null egress only gateway",
+			Content: `
+resource "aws_route" "foo" {
+  route_table_id         = "rtb-1234abcd"
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = "igw-1234abcd"
+  egress_only_gateway_id = null
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsRouteDestinationIPVersionMismatchRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsRouteDuplicateDestinationRule checks whether routes with the same destination are declared in the same route table
+type AwsRouteDuplicateDestinationRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsRouteDuplicateDestinationRule returns new rule with default attributes
+func NewAwsRouteDuplicateDestinationRule() *AwsRouteDuplicateDestinationRule {
+	return &AwsRouteDuplicateDestinationRule{}
+}
+
+// Name returns the rule name
+func (r *AwsRouteDuplicateDestinationRule) Name() string {
+	return "aws_route_duplicate_destination"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsRouteDuplicateDestinationRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsRouteDuplicateDestinationRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsRouteDuplicateDestinationRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each destination is unique in the route table.
+// Route tables are identified by resource addresses or literal route table IDs, and destinations with unknown values are ignored.
+func (r *AwsRouteDuplicateDestinationRule) Check(runner tflint.Runner) error {
+	// declared maps destination keys to the first route that declares them
+	declared := map[string]*tableRoute{}
+
+	return walkRoutes(runner, func(route *tableRoute) error {
+		if route.table == "" {
+			return nil
+		}
+
+		for _, name := range []string{route.destinations.ipv4, route.destinations.ipv6, route.destinations.prefixList} {
+			attribute, exists := route.body.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(destination string) error {
+				if destination == "" {
+					return nil
+				}
+
+				// CIDR blocks are compared in the canonical form, e.g. "10.0.0.0/16" and "10.0.1.0/16"
+				normalized := destination
+				if prefix, err := netip.ParsePrefix(destination); err == nil {
+					normalized = prefix.Masked().String()
+				}
+				key := fmt.Sprintf("%s/%s", route.table, normalized)
+
+				first, exists := declared[key]
+				if !exists {
+					declared[key] = route
+					return nil
+				}
+
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The destination "%s" is already declared by %s`, destination, first),
+					route.defRange,
+				)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
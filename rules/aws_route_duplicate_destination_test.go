@@ -0,0 +1,157 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsRouteDuplicateDestination(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "duplicate aws_route in the same route table",
+			Content: `
+resource "aws_route_table" "main" {
+  vpc_id = "vpc-1234abcd"
+}
+
+resource "aws_route" "internet" {
+  route_table_id         = aws_route_table.main.id
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = "igw-1234abcd"
+}
+
+resource "aws_route" "nat" {
+  route_table_id         = aws_route_table.main.id
+  destination_cidr_block = "0.0.0.0/0"
+  nat_gateway_id         = "nat-1234abcd"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRouteDuplicateDestinationRule(),
+					Message: `The destination "0.0.0.0/0" is already declared by aws_route.internet`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 1},
+						End:      hcl.Pos{Line: 12, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "same destination in different route tables",
+			Content: `
+resource "aws_route" "public" {
+  route_table_id         = "rtb-1234abcd"
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = "igw-1234abcd"
+}
+
+resource "aws_route" "private" {
+  route_table_id         = "rtb-5678abcd"
+  destination_cidr_block = "0.0.0.0/0"
+  nat_gateway_id         = "nat-1234abcd"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "duplicate inline routes",
+			Content: `
+resource "aws_route_table" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  route {
+    cidr_block = "10.1.0.0/16"
+    gateway_id = "igw-1234abcd"
+  }
+
+  route {
+    cidr_block                = "10.1.2.0/16"
+    vpc_peering_connection_id = "pcx-1234abcd"
+  }
+
+  route {
+    ipv6_cidr_block        = "::/0"
+    egress_only_gateway_id = "eigw-1234abcd"
+  }
+}
+
+resource "aws_route" "ipv6" {
+  route_table_id              = aws_route_table.main.id
+  destination_ipv6_cidr_block = "::/0"
+  gateway_id                  = "igw-1234abcd"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRouteDuplicateDestinationRule(),
+					Message: `The destination "10.1.2.0/16" is already declared by a route block of aws_route_table.main`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 3},
+						End:      hcl.Pos{Line: 10, Column: 8},
+					},
+				},
+				{
+					Rule:    NewAwsRouteDuplicateDestinationRule(),
+					Message: `The destination "::/0" is already declared by aws_route.ipv6`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 3},
+						End:      hcl.Pos{Line: 15, Column: 8},
+					},
+				},
+			},
+		},
+		{
+			Name: "prefix lists in the default route table",
+			Content: `
+resource "aws_default_route_table" "main" {
+  default_route_table_id = "rtb-1234abcd"
+
+  route {
+    destination_prefix_list_id = "pl-1234abcd"
+    gateway_id                 = "igw-1234abcd"
+  }
+
+  route {
+    destination_prefix_list_id = "pl-5678abcd"
+    gateway_id                 = "igw-1234abcd"
+  }
+}
+
+resource "aws_route" "s3" {
+  route_table_id             = aws_default_route_table.main.id
+  destination_prefix_list_id = "pl-1234abcd"
+  vpc_endpoint_id            = "vpce-1234abcd"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRouteDuplicateDestinationRule(),
+					Message: `The destination "pl-1234abcd" is already declared by aws_route.s3`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 8},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsRouteDuplicateDestinationRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
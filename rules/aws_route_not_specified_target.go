@@ -1,24 +1,20 @@
 package rules
 
 import (
-	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"fmt"
+
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/terraform-linters/tflint-ruleset-aws/project"
-	"github.com/zclconf/go-cty/cty"
 )
 
 // AwsRouteNotSpecifiedTargetRule checks whether a route definition has a routing target
 type AwsRouteNotSpecifiedTargetRule struct {
 	tflint.DefaultRule
-
-	resourceType string
 }
 
 // NewAwsRouteNotSpecifiedTargetRule returns new rule with default attributes
 func NewAwsRouteNotSpecifiedTargetRule() *AwsRouteNotSpecifiedTargetRule {
-	return &AwsRouteNotSpecifiedTargetRule{
-		resourceType: "aws_route",
-	}
+	return &AwsRouteNotSpecifiedTargetRule{}
 }
 
 // Name returns the rule name
@@ -41,49 +37,25 @@ func (r *AwsRouteNotSpecifiedTargetRule) Link() string {
 	return project.ReferenceLink(r.Name())
 }
 
-// Check checks whether a target is defined in a resource
+// Check checks whether a target is defined in routes.
+// Inline route blocks of aws_route_table and aws_default_route_table are checked as well as aws_route.
 func (r *AwsRouteNotSpecifiedTargetRule) Check(runner tflint.Runner) error {
-	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{
-			{Name: "gateway_id"},
-			{Name: "egress_only_gateway_id"},
-			{Name: "nat_gateway_id"},
-			{Name: "instance_id"},
-			{Name: "vpc_peering_connection_id"},
-			{Name: "network_interface_id"},
-			{Name: "transit_gateway_id"},
-			{Name: "vpc_endpoint_id"},
-			{Name: "carrier_gateway_id"},
-			{Name: "local_gateway_id"},
-			{Name: "core_network_arn"},
-		},
-	}, nil)
-	if err != nil {
-		return err
-	}
-
-	for _, resource := range resources.Blocks {
-		var nullAttributes int
-		for _, attribute := range resource.Body.Attributes {
-			err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
-				if val.IsNull() {
-					nullAttributes = nullAttributes + 1
-				}
-				return nil
-			}, nil)
-			if err != nil {
-				return err
-			}
+	return walkRoutes(runner, func(route *tableRoute) error {
+		count, err := countRouteTargets(runner, route, routeCoreNetworkAttribute)
+		if err != nil {
+			return err
 		}
 
-		if len(resource.Body.Attributes)-nullAttributes == 0 {
+		if count == 0 {
 			runner.EmitIssue(
 				r,
-				"The routing target is not specified, each aws_route must contain either egress_only_gateway_id, gateway_id, instance_id, nat_gateway_id, network_interface_id, transit_gateway_id, vpc_peering_connection_id, core_network_arn or vpc_endpoint_id.",
-				resource.DefRange,
+				fmt.Sprintf(
+					"The routing target is not specified, each %s must contain either egress_only_gateway_id, gateway_id, instance_id, nat_gateway_id, network_interface_id, transit_gateway_id, vpc_peering_connection_id, core_network_arn or vpc_endpoint_id.",
+					route.kind(),
+				),
+				route.defRange,
 			)
 		}
-	}
-
-	return nil
+		return nil
+	})
 }
@@ -131,6 +131,51 @@ resource "aws_route" "foo" {
 }`,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "route target is not specified in an inline route",
+			Content: `
+resource "aws_route_table" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  route {
+    cidr_block = "0.0.0.0/0"
+  }
+
+  route {
+    ipv6_cidr_block        = "::/0"
+    egress_only_gateway_id = "eigw-1234abcd"
+  }
+}
+
+resource "aws_default_route_table" "main" {
+  default_route_table_id = "rtb-1234abcd"
+
+  route {
+    cidr_block     = "10.1.0.0/16"
+    nat_gateway_id = null
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRouteNotSpecifiedTargetRule(),
+					Message: "The routing target is not specified, each route block of aws_route_table must contain either egress_only_gateway_id, gateway_id, instance_id, nat_gateway_id, network_interface_id, transit_gateway_id, vpc_peering_connection_id, core_network_arn or vpc_endpoint_id.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 8},
+					},
+				},
+				{
+					Rule:    NewAwsRouteNotSpecifiedTargetRule(),
+					Message: "The routing target is not specified, each route block of aws_default_route_table must contain either egress_only_gateway_id, gateway_id, instance_id, nat_gateway_id, network_interface_id, transit_gateway_id, vpc_peering_connection_id, core_network_arn or vpc_endpoint_id.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 18, Column: 3},
+						End:      hcl.Pos{Line: 18, Column: 8},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAwsRouteNotSpecifiedTargetRule()
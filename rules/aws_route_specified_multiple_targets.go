@@ -1,24 +1,18 @@
 package rules
 
 import (
-	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/terraform-linters/tflint-ruleset-aws/project"
-	"github.com/zclconf/go-cty/cty"
 )
 
 // AwsRouteSpecifiedMultipleTargetsRule checks whether a route definition has multiple routing targets
 type AwsRouteSpecifiedMultipleTargetsRule struct {
 	tflint.DefaultRule
-
-	resourceType string
 }
 
 // NewAwsRouteSpecifiedMultipleTargetsRule returns new rule with default attributes
 func NewAwsRouteSpecifiedMultipleTargetsRule() *AwsRouteSpecifiedMultipleTargetsRule {
-	return &AwsRouteSpecifiedMultipleTargetsRule{
-		resourceType: "aws_route",
-	}
+	return &AwsRouteSpecifiedMultipleTargetsRule{}
 }
 
 // Name returns the rule name
@@ -41,48 +35,22 @@ func (r *AwsRouteSpecifiedMultipleTargetsRule) Link() string {
 	return project.ReferenceLink(r.Name())
 }
 
-// Check checks whether a resource defines multiple targets
+// Check checks whether routes define multiple targets.
+// Inline route blocks of aws_route_table and aws_default_route_table are checked as well as aws_route.
 func (r *AwsRouteSpecifiedMultipleTargetsRule) Check(runner tflint.Runner) error {
-	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{
-			{Name: "gateway_id"},
-			{Name: "egress_only_gateway_id"},
-			{Name: "nat_gateway_id"},
-			{Name: "instance_id"},
-			{Name: "vpc_peering_connection_id"},
-			{Name: "network_interface_id"},
-			{Name: "transit_gateway_id"},
-			{Name: "vpc_endpoint_id"},
-			{Name: "carrier_gateway_id"},
-			{Name: "local_gateway_id"},
-		},
-	}, nil)
-	if err != nil {
-		return err
-	}
-
-	for _, resource := range resources.Blocks {
-		var nullAttributes int
-		for _, attribute := range resource.Body.Attributes {
-			err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
-				if val.IsNull() {
-					nullAttributes = nullAttributes + 1
-				}
-				return nil
-			}, nil)
-			if err != nil {
-				return err
-			}
+	return walkRoutes(runner, func(route *tableRoute) error {
+		count, err := countRouteTargets(runner, route)
+		if err != nil {
+			return err
 		}
 
-		if len(resource.Body.Attributes)-nullAttributes > 1 {
+		if count > 1 {
 			runner.EmitIssue(
 				r,
 				"More than one routing target specified. It must be one.",
-				resource.DefRange,
+				route.defRange,
 			)
 		}
-	}
-
-	return nil
+		return nil
+	})
 }
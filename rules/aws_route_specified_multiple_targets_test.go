@@ -52,6 +52,54 @@ resource "aws_route" "foo" {
 }`,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "multiple route targets are specified in an inline route",
+			Content: `
+resource "aws_route_table" "main" {
+  vpc_id = "vpc-1234abcd"
+
+  route {
+    cidr_block             = "0.0.0.0/0"
+    gateway_id             = "igw-1234abcd"
+    nat_gateway_id         = "nat-1234abcd"
+  }
+
+  route {
+    ipv6_cidr_block        = "::/0"
+    egress_only_gateway_id = "eigw-1234abcd"
+  }
+}
+
+resource "aws_default_route_table" "main" {
+  default_route_table_id = "rtb-1234abcd"
+
+  route {
+    cidr_block         = "10.1.0.0/16"
+    transit_gateway_id = "tgw-1234abcd"
+    vpc_endpoint_id    = "vpce-1234abcd"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsRouteSpecifiedMultipleTargetsRule(),
+					Message: "More than one routing target specified. It must be one.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 8},
+					},
+				},
+				{
+					Rule:    NewAwsRouteSpecifiedMultipleTargetsRule(),
+					Message: "More than one routing target specified. It must be one.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 20, Column: 3},
+						End:      hcl.Pos{Line: 20, Column: 8},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAwsRouteSpecifiedMultipleTargetsRule()
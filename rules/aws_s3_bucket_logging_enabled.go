@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsS3BucketLoggingEnabledRule checks whether S3 buckets enable server access logging
+type AwsS3BucketLoggingEnabledRule struct {
+	tflint.DefaultRule
+}
+
+// NewAwsS3BucketLoggingEnabledRule returns new rule with default attributes
+func NewAwsS3BucketLoggingEnabledRule() *AwsS3BucketLoggingEnabledRule {
+	return &AwsS3BucketLoggingEnabledRule{}
+}
+
+// Name returns the rule name
+func (r *AwsS3BucketLoggingEnabledRule) Name() string {
+	return "aws_s3_bucket_logging_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsS3BucketLoggingEnabledRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsS3BucketLoggingEnabledRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsS3BucketLoggingEnabledRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether each aws_s3_bucket has an aws_s3_bucket_logging or a legacy logging block.
+// Buckets are matched by references such as `aws_s3_bucket.example.id` or by literal bucket names.
+func (r *AwsS3BucketLoggingEnabledRule) Check(runner tflint.Runner) error {
+	loggings, err := runner.GetResourceContent("aws_s3_bucket_logging", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "bucket"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// logged contains resource addresses and bucket names that have aws_s3_bucket_logging
+	logged := map[string]bool{}
+	for _, resource := range loggings.Blocks {
+		attribute, exists := resource.Body.Attributes["bucket"]
+		if !exists {
+			continue
+		}
+
+		if address, ok := resourceReference(attribute.Expr, "aws_s3_bucket", "id", "bucket"); ok {
+			logged[address] = true
+			continue
+		}
+
+		// Logging whose bucket cannot be evaluated is skipped
+		if err := runner.EvaluateExpr(attribute.Expr, func(bucket string) error {
+			logged[bucket] = true
+			return nil
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	buckets, err := runner.GetResourceContent("aws_s3_bucket", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "bucket"}},
+		Blocks:     []hclext.BlockSchema{{Type: "logging", Body: &hclext.BodySchema{}}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range buckets.Blocks {
+		if len(resource.Body.Blocks) > 0 || logged[fmt.Sprintf("aws_s3_bucket.%s", resource.Labels[1])] {
+			continue
+		}
+
+		if attribute, exists := resource.Body.Attributes["bucket"]; exists {
+			found := false
+			if err := runner.EvaluateExpr(attribute.Expr, func(bucket string) error {
+				found = logged[bucket]
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+			if found {
+				continue
+			}
+		}
+
+		if err := runner.EmitIssue(r, "`aws_s3_bucket_logging` should be declared for the bucket to enable server access logging", resource.DefRange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsS3BucketLoggingEnabled(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "logging is not configured",
+			Content: `
+resource "aws_s3_bucket" "bucket" {
+  bucket = "bucket"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsS3BucketLoggingEnabledRule(),
+					Message: "`aws_s3_bucket_logging` should be declared for the bucket to enable server access logging",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "logging is configured",
+			Content: `
+resource "aws_s3_bucket" "referenced" {
+  bucket = "referenced"
+}
+
+resource "aws_s3_bucket_logging" "referenced" {
+  bucket        = aws_s3_bucket.referenced.id
+  target_bucket = "logs"
+  target_prefix = "log/"
+}
+
+resource "aws_s3_bucket" "named" {
+  bucket = "named"
+}
+
+resource "aws_s3_bucket_logging" "named" {
+  bucket        = "named"
+  target_bucket = "logs"
+  target_prefix = "log/"
+}
+
+resource "aws_s3_bucket" "legacy" {
+  bucket = "legacy"
+
+  logging {
+    target_bucket = "logs"
+  }
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "logged bucket cannot be evaluated",
+			Content: `
+resource "aws_s3_bucket_logging" "unknown" {
+  bucket        = module.bucket.id
+  target_bucket = "logs"
+  target_prefix = "log/"
+}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = "bucket"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsS3BucketLoggingEnabledRule(),
+					Message: "`aws_s3_bucket_logging` should be declared for the bucket to enable server access logging",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 34},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsS3BucketLoggingEnabledRule()
+
+	for _, tc := range cases {
+		runner := newUnknownModuleRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
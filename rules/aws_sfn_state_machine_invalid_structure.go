@@ -0,0 +1,219 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+	"golang.org/x/exp/maps"
+)
+
+// AwsSfnStateMachineInvalidStructureRule checks whether state machine definitions are valid Amazon States Language
+type AwsSfnStateMachineInvalidStructureRule struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+	// stateTypes maps state types to whether the type ends the execution by itself.
+	// Choice states neither end the execution nor declare Next or End, but transition by their Choices.
+	stateTypes map[string]bool
+}
+
+// aslStateMachine is a state machine, or a branch or an iterator of a state
+// https://states-language.net/spec.html
+type aslStateMachine struct {
+	StartAt string               `json:"StartAt"`
+	States  map[string]*aslState `json:"States"`
+}
+
+type aslState struct {
+	Type          string             `json:"Type"`
+	Next          string             `json:"Next"`
+	End           bool               `json:"End"`
+	Default       string             `json:"Default"`
+	Choices       []aslTransition    `json:"Choices"`
+	Catch         []aslTransition    `json:"Catch"`
+	Branches      []*aslStateMachine `json:"Branches"`
+	Iterator      *aslStateMachine   `json:"Iterator"`
+	ItemProcessor *aslStateMachine   `json:"ItemProcessor"`
+}
+
+type aslTransition struct {
+	Next string `json:"Next"`
+}
+
+// NewAwsSfnStateMachineInvalidStructureRule returns new rule with default attributes
+func NewAwsSfnStateMachineInvalidStructureRule() *AwsSfnStateMachineInvalidStructureRule {
+	return &AwsSfnStateMachineInvalidStructureRule{
+		resourceType:  "aws_sfn_state_machine",
+		attributeName: "definition",
+		stateTypes: map[string]bool{
+			"Task":     false,
+			"Pass":     false,
+			"Wait":     false,
+			"Parallel": false,
+			"Map":      false,
+			"Choice":   false,
+			"Succeed":  true,
+			"Fail":     true,
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSfnStateMachineInvalidStructureRule) Name() string {
+	return "aws_sfn_state_machine_invalid_structure"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSfnStateMachineInvalidStructureRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSfnStateMachineInvalidStructureRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSfnStateMachineInvalidStructureRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether StartAt and transitions refer to existing states and the state machine can terminate
+func (r *AwsSfnStateMachineInvalidStructureRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(definition string) error {
+			stateMachine := &aslStateMachine{}
+			if err := json.Unmarshal([]byte(definition), stateMachine); err != nil {
+				return runner.EmitIssue(r, fmt.Sprintf("definition is not a valid state machine: %s", err), attribute.Expr.Range())
+			}
+
+			for _, message := range r.validate(stateMachine, "the state machine") {
+				if err := runner.EmitIssue(r, message, attribute.Expr.Range()); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate returns messages for each error in the state machine. Branches and iterators are validated recursively.
+func (r *AwsSfnStateMachineInvalidStructureRule) validate(stateMachine *aslStateMachine, subject string) []string {
+	messages := []string{}
+
+	if len(stateMachine.States) == 0 {
+		return append(messages, fmt.Sprintf("No States are declared in %s", subject))
+	}
+
+	if stateMachine.StartAt == "" {
+		messages = append(messages, fmt.Sprintf("StartAt is missing in %s", subject))
+	} else if _, exists := stateMachine.States[stateMachine.StartAt]; !exists {
+		messages = append(messages, fmt.Sprintf(`StartAt "%s" does not exist in States of %s`, stateMachine.StartAt, subject))
+	}
+
+	names := maps.Keys(stateMachine.States)
+	sort.Strings(names)
+
+	terminal := false
+	for _, name := range names {
+		state := stateMachine.States[name]
+		if state == nil {
+			continue
+		}
+
+		ends, valid := r.stateTypes[state.Type]
+		if !valid {
+			messages = append(messages, fmt.Sprintf(`"%s" is an invalid Type of state "%s"`, state.Type, name))
+			continue
+		}
+		if ends || state.End {
+			terminal = true
+		}
+
+		checkTarget := func(field, target string) {
+			if _, exists := stateMachine.States[target]; !exists {
+				messages = append(messages, fmt.Sprintf(`%s "%s" of state "%s" does not exist in States`, field, target, name))
+			}
+		}
+
+		if !ends && state.Type != "Choice" {
+			switch {
+			case state.Next == "" && !state.End:
+				messages = append(messages, fmt.Sprintf(`State "%s" must have either Next or "End": true`, name))
+			case state.Next != "" && state.End:
+				messages = append(messages, fmt.Sprintf(`State "%s" cannot have both Next and "End": true`, name))
+			case state.Next != "":
+				checkTarget("Next", state.Next)
+			}
+		}
+
+		if state.Type == "Choice" {
+			if len(state.Choices) == 0 {
+				messages = append(messages, fmt.Sprintf(`Choice state "%s" has no Choices`, name))
+			}
+			for i, choice := range state.Choices {
+				if choice.Next == "" {
+					messages = append(messages, fmt.Sprintf(`Choice rule %d of state "%s" must have Next`, i, name))
+					continue
+				}
+				checkTarget("Next", choice.Next)
+			}
+			if state.Default != "" {
+				checkTarget("Default", state.Default)
+			}
+		}
+
+		for i, catcher := range state.Catch {
+			if catcher.Next == "" {
+				messages = append(messages, fmt.Sprintf(`Catcher %d of state "%s" must have Next`, i, name))
+				continue
+			}
+			checkTarget("Next", catcher.Next)
+		}
+
+		for i, branch := range state.Branches {
+			if branch == nil {
+				messages = append(messages, fmt.Sprintf(`Branch %d of state "%s" is null`, i, name))
+				continue
+			}
+			messages = append(messages, r.validate(branch, fmt.Sprintf(`branch %d of state "%s"`, i, name))...)
+		}
+		if state.Type == "Map" && state.Iterator == nil && state.ItemProcessor == nil {
+			messages = append(messages, fmt.Sprintf(`Map state "%s" must have either ItemProcessor or Iterator`, name))
+		}
+		if state.Iterator != nil {
+			messages = append(messages, r.validate(state.Iterator, fmt.Sprintf(`the Iterator of state "%s"`, name))...)
+		}
+		if state.ItemProcessor != nil {
+			messages = append(messages, r.validate(state.ItemProcessor, fmt.Sprintf(`the ItemProcessor of state "%s"`, name))...)
+		}
+	}
+
+	if !terminal {
+		messages = append(messages, fmt.Sprintf(`No terminal state is declared in %s. Add a Succeed or Fail state, or "End": true`, subject))
+	}
+
+	return messages
+}
@@ -0,0 +1,331 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsSfnStateMachineInvalidStructure(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "missing transition targets",
+			Content: `
+resource "aws_sfn_state_machine" "machine" {
+  definition = <<-EOF
+  {
+    "StartAt": "Begin",
+    "States": {
+      "Check": {
+        "Type": "Choice",
+        "Choices": [{"Variable": "$.ok", "BooleanEquals": true, "Next": "Done"}],
+        "Default": "Retry"
+      },
+      "Done": {"Type": "Succeed"},
+      "Work": {
+        "Type": "Task",
+        "Resource": "arn:aws:lambda:us-east-1:123456789012:function:work",
+        "Next": "Check",
+        "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}]
+      }
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `StartAt "Begin" does not exist in States of the state machine`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 21, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `Default "Retry" of state "Check" does not exist in States`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 21, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `Next "Failed" of state "Work" does not exist in States`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 21, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid states",
+			Content: `
+resource "aws_sfn_state_machine" "machine" {
+  definition = <<-EOF
+  {
+    "StartAt": "Wait",
+    "States": {
+      "Notify": {"Type": "Notification", "End": true},
+      "Transform": {"Type": "Pass", "Next": "Wait", "End": true},
+      "Wait": {"Type": "Wait", "Seconds": 10}
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `"Notification" is an invalid Type of state "Notify"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 12, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `State "Transform" cannot have both Next and "End": true`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 12, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `State "Wait" must have either Next or "End": true`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 12, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "branches",
+			Content: `
+resource "aws_sfn_state_machine" "machine" {
+  definition = <<-EOF
+  {
+    "StartAt": "Fanout",
+    "States": {
+      "Fanout": {
+        "Type": "Parallel",
+        "End": true,
+        "Branches": [
+          {"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}},
+          {"StartAt": "B", "States": {"B": {"Type": "Pass", "Next": "B"}}}
+        ]
+      },
+      "Each": {
+        "Type": "Map",
+        "Next": "Fanout",
+        "ItemProcessor": {"States": {"C": {"Type": "Succeed"}}}
+      }
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `StartAt is missing in the ItemProcessor of state "Each"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 22, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `No terminal state is declared in branch 1 of state "Fanout". Add a Succeed or Fail state, or "End": true`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 22, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "choice loop",
+			Content: `
+resource "aws_sfn_state_machine" "machine" {
+  definition = <<-EOF
+  {
+    "StartAt": "Check",
+    "States": {
+      "Check": {
+        "Type": "Choice",
+        "Choices": [{"Variable": "$.ok", "BooleanEquals": true, "Next": "Check"}],
+        "Default": "Check"
+      }
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `No terminal state is declared in the state machine. Add a Succeed or Fail state, or "End": true`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 14, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "choice rules and catchers without Next",
+			Content: `
+resource "aws_sfn_state_machine" "machine" {
+  definition = <<-EOF
+  {
+    "StartAt": "Work",
+    "States": {
+      "Work": {"Type": "Task", "Resource": "arn:aws:states:::lambda:invoke", "Next": "Check", "Catch": [{"ErrorEquals": ["States.ALL"]}]},
+      "Check": {
+        "Type": "Choice",
+        "Choices": [{"Variable": "$.ok", "BooleanEquals": true}],
+        "Default": "Done"
+      },
+      "Done": {"Type": "Succeed"}
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `Choice rule 0 of state "Check" must have Next`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 16, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `Catcher 0 of state "Work" must have Next`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 16, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "null branches and iterators",
+			Content: `
+resource "aws_sfn_state_machine" "machine" {
+  definition = <<-EOF
+  {
+    "StartAt": "Fanout",
+    "States": {
+      "Fanout": {"Type": "Parallel", "Next": "Each", "Branches": [null]},
+      "Each": {"Type": "Map", "End": true, "Iterator": null}
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `Map state "Each" must have either ItemProcessor or Iterator`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 11, Column: 6},
+					},
+				},
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: `Branch 0 of state "Fanout" is null`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 11, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid JSON",
+			Content: `
+resource "aws_sfn_state_machine" "machine" {
+  definition = "[]"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsSfnStateMachineInvalidStructureRule(),
+					Message: "definition is not a valid state machine: json: cannot unmarshal array into Go value of type rules.aslStateMachine",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_sfn_state_machine" "machine" {
+  definition = <<-EOF
+  {
+    "StartAt": "Work",
+    "States": {
+      "Work": {
+        "Type": "Task",
+        "Resource": "arn:aws:lambda:us-east-1:123456789012:function:work",
+        "Next": "Check",
+        "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}]
+      },
+      "Check": {
+        "Type": "Choice",
+        "Choices": [{"Variable": "$.ok", "BooleanEquals": true, "Next": "Done"}],
+        "Default": "Failed"
+      },
+      "Done": {"Type": "Succeed"},
+      "Failed": {"Type": "Fail"}
+    }
+  }
+  EOF
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsSfnStateMachineInvalidStructureRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/aws"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsVpcEndpointInvalidServiceNameRule checks whether service_name of VPC endpoints is an AWS service name in the region of the provider
+type AwsVpcEndpointInvalidServiceNameRule struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+	// pattern matches AWS service names and endpoint service names, such as "com.amazonaws.us-east-1.s3" and "com.amazonaws.vpce.us-east-1.vpce-svc-1234abcd"
+	pattern *regexp.Regexp
+	// regionPattern matches region names. Global service names such as "com.amazonaws.s3-global.accesspoint" have no region.
+	regionPattern *regexp.Regexp
+}
+
+// NewAwsVpcEndpointInvalidServiceNameRule returns new rule with default attributes
+func NewAwsVpcEndpointInvalidServiceNameRule() *AwsVpcEndpointInvalidServiceNameRule {
+	return &AwsVpcEndpointInvalidServiceNameRule{
+		resourceType:  "aws_vpc_endpoint",
+		attributeName: "service_name",
+		pattern:       regexp.MustCompile(`^com\.amazonaws\.(?:vpce\.)?([a-z0-9-]+)\.[a-z0-9-][a-z0-9.-]*$`),
+		regionPattern: regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`),
+	}
+}
+
+// Name returns the rule name
+func (r *AwsVpcEndpointInvalidServiceNameRule) Name() string {
+	return "aws_vpc_endpoint_invalid_service_name"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsVpcEndpointInvalidServiceNameRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsVpcEndpointInvalidServiceNameRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsVpcEndpointInvalidServiceNameRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether service_name matches com.amazonaws.<region>.<service>, and the region is the region of the provider.
+// Service names of other prefixes such as "aws.sagemaker." and "cn.com.amazonaws." are ignored.
+// The region is not compared if the provider region cannot be determined, or the service is global.
+func (r *AwsVpcEndpointInvalidServiceNameRule) Check(runner tflint.Runner) error {
+	regions, err := aws.NewRegionResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+			{Name: "provider"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		region := regions.ResourceRegion(resource)
+
+		err = runner.EvaluateExpr(attribute.Expr, func(serviceName string) error {
+			if !strings.HasPrefix(serviceName, "com.amazonaws.") {
+				return nil
+			}
+
+			matches := r.pattern.FindStringSubmatch(serviceName)
+			if matches == nil {
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf(`"%s" does not match the format com.amazonaws.<region>.<service>`, serviceName),
+					attribute.Expr.Range(),
+				)
+			}
+
+			if region == "" || !r.regionPattern.MatchString(matches[1]) || matches[1] == region {
+				return nil
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf(`The service "%s" is in %s, but the provider region is %s`, serviceName, matches[1], region),
+				attribute.Expr.Range(),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
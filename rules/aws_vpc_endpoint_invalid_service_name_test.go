@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsVpcEndpointInvalidServiceName(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "different region",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_vpc_endpoint" "s3" {
+  vpc_id       = "vpc-1234abcd"
+  service_name = "com.amazonaws.us-west-2.s3"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcEndpointInvalidServiceNameRule(),
+					Message: `The service "com.amazonaws.us-west-2.s3" is in us-west-2, but the provider region is us-east-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 18},
+						End:      hcl.Pos{Line: 8, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "aliased provider",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+
+resource "aws_vpc_endpoint" "s3" {
+  provider = aws.west
+
+  vpc_id       = "vpc-1234abcd"
+  service_name = "com.amazonaws.us-west-2.s3"
+}
+
+resource "aws_vpc_endpoint" "service" {
+  provider = aws.west
+
+  vpc_id            = "vpc-1234abcd"
+  service_name      = "com.amazonaws.vpce.us-east-1.vpce-svc-1234abcd"
+  vpc_endpoint_type = "Interface"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcEndpointInvalidServiceNameRule(),
+					Message: `The service "com.amazonaws.vpce.us-east-1.vpce-svc-1234abcd" is in us-east-1, but the provider region is us-west-2`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 22, Column: 23},
+						End:      hcl.Pos{Line: 22, Column: 71},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid format",
+			Content: `
+resource "aws_vpc_endpoint" "s3" {
+  vpc_id       = "vpc-1234abcd"
+  service_name = "com.amazonaws.s3"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcEndpointInvalidServiceNameRule(),
+					Message: `"com.amazonaws.s3" does not match the format com.amazonaws.<region>.<service>`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 18},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "global service",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_vpc_endpoint" "access_point" {
+  vpc_id            = "vpc-1234abcd"
+  service_name      = "com.amazonaws.s3-global.accesspoint"
+  vpc_endpoint_type = "Interface"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unknown provider region and other prefixes",
+			Content: `
+resource "aws_vpc_endpoint" "s3" {
+  vpc_id       = "vpc-1234abcd"
+  service_name = "com.amazonaws.us-west-2.s3"
+}
+
+resource "aws_vpc_endpoint" "notebook" {
+  vpc_id            = "vpc-1234abcd"
+  service_name      = "aws.sagemaker.us-west-2.notebook"
+  vpc_endpoint_type = "Interface"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsVpcEndpointInvalidServiceNameRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
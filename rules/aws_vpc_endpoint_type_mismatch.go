@@ -0,0 +1,146 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsVpcEndpointTypeMismatchRule checks whether the configuration of VPC endpoints matches vpc_endpoint_type
+type AwsVpcEndpointTypeMismatchRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+	// disallowedAttributes maps endpoint types to the attributes that cannot be used with them
+	disallowedAttributes map[string][]string
+	// gatewayServices are services that support Gateway endpoints
+	gatewayServices []string
+}
+
+// NewAwsVpcEndpointTypeMismatchRule returns new rule with default attributes
+func NewAwsVpcEndpointTypeMismatchRule() *AwsVpcEndpointTypeMismatchRule {
+	return &AwsVpcEndpointTypeMismatchRule{
+		resourceType: "aws_vpc_endpoint",
+		disallowedAttributes: map[string][]string{
+			"Gateway":             {"subnet_ids", "security_group_ids"},
+			"Interface":           {"route_table_ids"},
+			"GatewayLoadBalancer": {"route_table_ids", "security_group_ids"},
+		},
+		gatewayServices: []string{"s3", "dynamodb"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsVpcEndpointTypeMismatchRule) Name() string {
+	return "aws_vpc_endpoint_type_mismatch"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsVpcEndpointTypeMismatchRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsVpcEndpointTypeMismatchRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsVpcEndpointTypeMismatchRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks subnet_ids, route_table_ids, security_group_ids, private_dns_enabled and service_name against vpc_endpoint_type.
+// vpc_endpoint_type defaults to "Gateway", and endpoints with unknown types are ignored.
+func (r *AwsVpcEndpointTypeMismatchRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "vpc_endpoint_type"},
+			{Name: "service_name"},
+			{Name: "subnet_ids"},
+			{Name: "route_table_ids"},
+			{Name: "security_group_ids"},
+			{Name: "private_dns_enabled"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		endpointType := "Gateway"
+		if attribute, exists := resource.Body.Attributes["vpc_endpoint_type"]; exists {
+			endpointType = ""
+			if err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+				endpointType = val
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+		}
+		disallowed, known := r.disallowedAttributes[endpointType]
+		if !known {
+			continue
+		}
+
+		for _, name := range disallowed {
+			set, err := isAttributeSet(runner, resource.Body, name)
+			if err != nil {
+				return err
+			}
+			if !set {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s cannot be set for %s endpoints", name, endpointType),
+				resource.Body.Attributes[name].Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+
+		if endpointType != "Interface" {
+			if attribute, exists := resource.Body.Attributes["private_dns_enabled"]; exists {
+				err := runner.EvaluateExpr(attribute.Expr, func(enabled bool) error {
+					if !enabled {
+						return nil
+					}
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf("private_dns_enabled is only valid for Interface endpoints, but the endpoint type is %s", endpointType),
+						attribute.Expr.Range(),
+					)
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if endpointType == "Gateway" {
+			if attribute, exists := resource.Body.Attributes["service_name"]; exists {
+				err := runner.EvaluateExpr(attribute.Expr, func(serviceName string) error {
+					parts := strings.Split(serviceName, ".")
+					if stringInSlice(parts[len(parts)-1], r.gatewayServices) {
+						return nil
+					}
+					return runner.EmitIssue(
+						r,
+						fmt.Sprintf(`Gateway endpoints are only available for %s, but the service is "%s"`, strings.Join(r.gatewayServices, " and "), serviceName),
+						attribute.Expr.Range(),
+					)
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
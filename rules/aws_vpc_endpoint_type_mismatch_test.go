@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsVpcEndpointTypeMismatch(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Gateway endpoint with interface attributes",
+			Content: `
+resource "aws_vpc_endpoint" "s3" {
+  vpc_id              = "vpc-1234abcd"
+  service_name        = "com.amazonaws.us-east-1.s3"
+  subnet_ids          = ["subnet-1234abcd"]
+  private_dns_enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcEndpointTypeMismatchRule(),
+					Message: "subnet_ids cannot be set for Gateway endpoints",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 25},
+						End:      hcl.Pos{Line: 5, Column: 44},
+					},
+				},
+				{
+					Rule:    NewAwsVpcEndpointTypeMismatchRule(),
+					Message: "private_dns_enabled is only valid for Interface endpoints, but the endpoint type is Gateway",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 25},
+						End:      hcl.Pos{Line: 6, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "Gateway endpoint for unsupported service",
+			Content: `
+resource "aws_vpc_endpoint" "sqs" {
+  vpc_id            = "vpc-1234abcd"
+  service_name      = "com.amazonaws.us-east-1.sqs"
+  vpc_endpoint_type = "Gateway"
+  route_table_ids   = ["rtb-1234abcd"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcEndpointTypeMismatchRule(),
+					Message: `Gateway endpoints are only available for s3 and dynamodb, but the service is "com.amazonaws.us-east-1.sqs"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 52},
+					},
+				},
+			},
+		},
+		{
+			Name: "Interface endpoint with route tables",
+			Content: `
+resource "aws_vpc_endpoint" "sqs" {
+  vpc_id              = "vpc-1234abcd"
+  service_name        = "com.amazonaws.us-east-1.sqs"
+  vpc_endpoint_type   = "Interface"
+  subnet_ids          = ["subnet-1234abcd"]
+  route_table_ids     = ["rtb-1234abcd"]
+  private_dns_enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcEndpointTypeMismatchRule(),
+					Message: "route_table_ids cannot be set for Interface endpoints",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 25},
+						End:      hcl.Pos{Line: 7, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid endpoints",
+			Content: `
+resource "aws_vpc_endpoint" "s3" {
+  vpc_id              = "vpc-1234abcd"
+  service_name        = "com.amazonaws.us-east-1.s3"
+  route_table_ids     = ["rtb-1234abcd"]
+  private_dns_enabled = false
+}
+
+resource "aws_vpc_endpoint" "s3_interface" {
+  vpc_id              = "vpc-1234abcd"
+  service_name        = "com.amazonaws.us-east-1.s3"
+  vpc_endpoint_type   = "Interface"
+  subnet_ids          = ["subnet-1234abcd"]
+  security_group_ids  = ["sg-1234abcd"]
+  private_dns_enabled = true
+}
+
+resource "aws_vpc_endpoint" "inspection" {
+  vpc_id            = "vpc-1234abcd"
+  service_name      = "com.amazonaws.vpce.us-east-1.vpce-svc-1234abcd"
+  vpc_endpoint_type = "GatewayLoadBalancer"
+  subnet_ids        = ["subnet-1234abcd"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsVpcEndpointTypeMismatchRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,361 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsVpcInvalidCidrBlockRule checks whether CIDR blocks of VPCs and subnets are consistent with each other
+type AwsVpcInvalidCidrBlockRule struct {
+	tflint.DefaultRule
+
+	minIPv4PrefixLength int
+	maxIPv4PrefixLength int
+}
+
+// vpcCIDRBlock is a CIDR block declared in an attribute of a resource
+type vpcCIDRBlock struct {
+	address   string
+	prefix    netip.Prefix
+	attribute *hclext.Attribute
+}
+
+// vpcNetwork is CIDR blocks of a VPC, including secondary CIDR blocks of aws_vpc_ipv4_cidr_block_association.
+// If a CIDR block is unknown (e.g. allocated from IPAM), the blocks of that family are incomplete.
+type vpcNetwork struct {
+	blocks           []vpcCIDRBlock
+	incompleteFamily map[bool]bool
+}
+
+// NewAwsVpcInvalidCidrBlockRule returns new rule with default attributes
+func NewAwsVpcInvalidCidrBlockRule() *AwsVpcInvalidCidrBlockRule {
+	return &AwsVpcInvalidCidrBlockRule{
+		minIPv4PrefixLength: 16,
+		maxIPv4PrefixLength: 28,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsVpcInvalidCidrBlockRule) Name() string {
+	return "aws_vpc_invalid_cidr_block"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsVpcInvalidCidrBlockRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsVpcInvalidCidrBlockRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsVpcInvalidCidrBlockRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks prefix lengths, subnets outside VPCs, overlapping subnets,
+// and overlapping VPCs connected by peering connections or transit gateways.
+// VPCs are resolved by references such as `aws_vpc.main.id`.
+func (r *AwsVpcInvalidCidrBlockRule) Check(runner tflint.Runner) error {
+	networks, err := r.collectNetworks(runner)
+	if err != nil {
+		return err
+	}
+
+	if err := r.checkSubnets(runner, networks); err != nil {
+		return err
+	}
+
+	return r.checkConnections(runner, networks)
+}
+
+// collectNetworks returns CIDR blocks of each VPC keyed by resource address
+func (r *AwsVpcInvalidCidrBlockRule) collectNetworks(runner tflint.Runner) (map[string]*vpcNetwork, error) {
+	networks := map[string]*vpcNetwork{}
+
+	vpcs, err := runner.GetResourceContent("aws_vpc", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "cidr_block"}, {Name: "ipv6_cidr_block"}},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range vpcs.Blocks {
+		address := fmt.Sprintf("aws_vpc.%s", resource.Labels[1])
+		network := &vpcNetwork{incompleteFamily: map[bool]bool{}}
+		networks[address] = network
+
+		for _, name := range []string{"cidr_block", "ipv6_cidr_block"} {
+			if err := r.addBlock(runner, network, address, resource.Body.Attributes[name], name == "ipv6_cidr_block"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	associations, err := runner.GetResourceContent("aws_vpc_ipv4_cidr_block_association", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "vpc_id"}, {Name: "cidr_block"}},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range associations.Blocks {
+		attribute, exists := resource.Body.Attributes["vpc_id"]
+		if !exists {
+			continue
+		}
+		vpc, ok := resourceReference(attribute.Expr, "aws_vpc", "id")
+		if !ok || networks[vpc] == nil {
+			continue
+		}
+
+		address := fmt.Sprintf("aws_vpc_ipv4_cidr_block_association.%s", resource.Labels[1])
+		if err := r.addBlock(runner, networks[vpc], address, resource.Body.Attributes["cidr_block"], false); err != nil {
+			return nil, err
+		}
+	}
+
+	return networks, nil
+}
+
+// addBlock adds the CIDR block in the attribute to the network, and checks the prefix length of IPv4 blocks
+func (r *AwsVpcInvalidCidrBlockRule) addBlock(runner tflint.Runner, network *vpcNetwork, address string, attribute *hclext.Attribute, ipv6 bool) error {
+	if attribute == nil {
+		// CIDR blocks are allocated from IPAM or provided by Amazon
+		network.incompleteFamily[ipv6] = true
+		return nil
+	}
+
+	block, known, err := r.evaluateBlock(runner, address, attribute)
+	if err != nil || !known {
+		network.incompleteFamily[ipv6] = true
+		return err
+	}
+	if block == nil {
+		return nil
+	}
+
+	network.blocks = append(network.blocks, *block)
+	return r.checkPrefixLength(runner, *block)
+}
+
+// evaluateBlock returns the CIDR block of the attribute. A nil block is returned if the value is not a valid CIDR block.
+func (r *AwsVpcInvalidCidrBlockRule) evaluateBlock(runner tflint.Runner, address string, attribute *hclext.Attribute) (*vpcCIDRBlock, bool, error) {
+	var block *vpcCIDRBlock
+	known := false
+
+	err := runner.EvaluateExpr(attribute.Expr, func(cidr string) error {
+		known = true
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			// Invalid CIDR blocks are reported by the provider
+			return nil
+		}
+		block = &vpcCIDRBlock{address: address, prefix: prefix.Masked(), attribute: attribute}
+		return nil
+	}, nil)
+	return block, known, err
+}
+
+// checkPrefixLength checks that IPv4 CIDR blocks are between /16 and /28
+func (r *AwsVpcInvalidCidrBlockRule) checkPrefixLength(runner tflint.Runner, block vpcCIDRBlock) error {
+	if !block.prefix.Addr().Is4() {
+		return nil
+	}
+	if bits := block.prefix.Bits(); bits >= r.minIPv4PrefixLength && bits <= r.maxIPv4PrefixLength {
+		return nil
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf(`The prefix length of "%s" must be between /%d and /%d`, block.prefix, r.minIPv4PrefixLength, r.maxIPv4PrefixLength),
+		block.attribute.Expr.Range(),
+	)
+}
+
+// checkSubnets checks that subnets are within their VPC and do not overlap with other subnets in the VPC
+func (r *AwsVpcInvalidCidrBlockRule) checkSubnets(runner tflint.Runner, networks map[string]*vpcNetwork) error {
+	subnets, err := runner.GetResourceContent("aws_subnet", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "vpc_id"}, {Name: "cidr_block"}, {Name: "ipv6_cidr_block"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// declared is subnet CIDR blocks keyed by VPC addresses
+	declared := map[string][]vpcCIDRBlock{}
+
+	for _, resource := range subnets.Blocks {
+		address := fmt.Sprintf("aws_subnet.%s", resource.Labels[1])
+
+		vpc := ""
+		if attribute, exists := resource.Body.Attributes["vpc_id"]; exists {
+			vpc, _ = resourceReference(attribute.Expr, "aws_vpc", "id")
+		}
+		network := networks[vpc]
+
+		for _, name := range []string{"cidr_block", "ipv6_cidr_block"} {
+			attribute, exists := resource.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			block, _, err := r.evaluateBlock(runner, address, attribute)
+			if err != nil {
+				return err
+			}
+			if block == nil {
+				continue
+			}
+
+			if err := r.checkPrefixLength(runner, *block); err != nil {
+				return err
+			}
+			if network == nil {
+				continue
+			}
+
+			if err := r.checkContainment(runner, network, vpc, *block); err != nil {
+				return err
+			}
+
+			for _, other := range declared[vpc] {
+				if !other.prefix.Overlaps(block.prefix) {
+					continue
+				}
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The CIDR block "%s" overlaps with "%s" of %s`, block.prefix, other.prefix, other.address),
+					attribute.Expr.Range(),
+				); err != nil {
+					return err
+				}
+				break
+			}
+			declared[vpc] = append(declared[vpc], *block)
+		}
+	}
+
+	return nil
+}
+
+// checkContainment checks that the subnet CIDR block is within one of the CIDR blocks of the VPC
+func (r *AwsVpcInvalidCidrBlockRule) checkContainment(runner tflint.Runner, network *vpcNetwork, vpc string, block vpcCIDRBlock) error {
+	ipv6 := block.prefix.Addr().Is6()
+	if network.incompleteFamily[ipv6] {
+		return nil
+	}
+
+	for _, parent := range network.blocks {
+		if parent.prefix.Addr().Is6() != ipv6 {
+			continue
+		}
+		if parent.prefix.Bits() <= block.prefix.Bits() && parent.prefix.Contains(block.prefix.Addr()) {
+			return nil
+		}
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf(`The CIDR block "%s" is not within the CIDR blocks of %s`, block.prefix, vpc),
+		block.attribute.Expr.Range(),
+	)
+}
+
+// checkConnections checks that VPCs connected by peering connections or attached to the same transit gateway do not overlap
+func (r *AwsVpcInvalidCidrBlockRule) checkConnections(runner tflint.Runner, networks map[string]*vpcNetwork) error {
+	peerings, err := runner.GetResourceContent("aws_vpc_peering_connection", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "vpc_id"}, {Name: "peer_vpc_id"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range peerings.Blocks {
+		vpc, peer := "", ""
+		if attribute, exists := resource.Body.Attributes["vpc_id"]; exists {
+			vpc, _ = resourceReference(attribute.Expr, "aws_vpc", "id")
+		}
+		if attribute, exists := resource.Body.Attributes["peer_vpc_id"]; exists {
+			peer, _ = resourceReference(attribute.Expr, "aws_vpc", "id")
+		}
+
+		if err := r.checkOverlappingVPCs(runner, networks, vpc, peer, resource); err != nil {
+			return err
+		}
+	}
+
+	attachments, err := runner.GetResourceContent("aws_ec2_transit_gateway_vpc_attachment", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "vpc_id"}, {Name: "transit_gateway_id"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// attached is VPC addresses keyed by transit gateways
+	attached := map[string][]string{}
+
+	for _, resource := range attachments.Blocks {
+		vpcAttr, exists := resource.Body.Attributes["vpc_id"]
+		if !exists {
+			continue
+		}
+		gatewayAttr, exists := resource.Body.Attributes["transit_gateway_id"]
+		if !exists {
+			continue
+		}
+
+		vpc, ok := resourceReference(vpcAttr.Expr, "aws_vpc", "id")
+		if !ok {
+			continue
+		}
+		gateway, ok := resourceReference(gatewayAttr.Expr, "aws_ec2_transit_gateway", "id")
+		if !ok {
+			if err := runner.EvaluateExpr(gatewayAttr.Expr, func(id string) error {
+				gateway = id
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+		}
+		if gateway == "" {
+			continue
+		}
+
+		for _, other := range attached[gateway] {
+			if err := r.checkOverlappingVPCs(runner, networks, other, vpc, resource); err != nil {
+				return err
+			}
+		}
+		attached[gateway] = append(attached[gateway], vpc)
+	}
+
+	return nil
+}
+
+// checkOverlappingVPCs emits an issue at the connection if CIDR blocks of the VPCs overlap
+func (r *AwsVpcInvalidCidrBlockRule) checkOverlappingVPCs(runner tflint.Runner, networks map[string]*vpcNetwork, vpc, peer string, connection *hclext.Block) error {
+	if networks[vpc] == nil || networks[peer] == nil || vpc == peer {
+		return nil
+	}
+
+	for _, block := range networks[vpc].blocks {
+		for _, other := range networks[peer].blocks {
+			if !block.prefix.Overlaps(other.prefix) {
+				continue
+			}
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf(`The CIDR block "%s" of %s overlaps with "%s" of %s`, block.prefix, vpc, other.prefix, peer),
+				connection.DefRange,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsVpcInvalidCidrBlock(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "subnets",
+			Content: `
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc_ipv4_cidr_block_association" "secondary" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_subnet" "a" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.0.0/23"
+}
+
+resource "aws_subnet" "b" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.1.0/24"
+}
+
+resource "aws_subnet" "c" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.2.0.0/24"
+}
+
+resource "aws_subnet" "d" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.1.0.0/28"
+}
+
+resource "aws_subnet" "e" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.1.1.0/30"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcInvalidCidrBlockRule(),
+					Message: `The CIDR block "10.0.1.0/24" overlaps with "10.0.0.0/23" of aws_subnet.a`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 18, Column: 16},
+						End:      hcl.Pos{Line: 18, Column: 29},
+					},
+				},
+				{
+					Rule:    NewAwsVpcInvalidCidrBlockRule(),
+					Message: `The CIDR block "10.2.0.0/24" is not within the CIDR blocks of aws_vpc.main`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 23, Column: 16},
+						End:      hcl.Pos{Line: 23, Column: 29},
+					},
+				},
+				{
+					Rule:    NewAwsVpcInvalidCidrBlockRule(),
+					Message: `The prefix length of "10.1.1.0/30" must be between /16 and /28`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 33, Column: 16},
+						End:      hcl.Pos{Line: 33, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "VPC prefix length",
+			Content: `
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/8"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcInvalidCidrBlockRule(),
+					Message: `The prefix length of "10.0.0.0/8" must be between /16 and /28`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "IPv6 subnets",
+			Content: `
+resource "aws_vpc" "main" {
+  cidr_block      = "10.0.0.0/16"
+  ipv6_cidr_block = "2001:db8:1234:1a00::/56"
+}
+
+resource "aws_vpc" "amazon_provided" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+}
+
+resource "aws_subnet" "a" {
+  vpc_id          = aws_vpc.main.id
+  cidr_block      = "10.0.0.0/24"
+  ipv6_cidr_block = "2001:db8:1234:1b00::/64"
+}
+
+resource "aws_subnet" "b" {
+  vpc_id          = aws_vpc.amazon_provided.id
+  cidr_block      = "10.1.0.0/24"
+  ipv6_cidr_block = "2001:db8:1234:1b00::/64"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcInvalidCidrBlockRule(),
+					Message: `The CIDR block "2001:db8:1234:1b00::/64" is not within the CIDR blocks of aws_vpc.main`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 21},
+						End:      hcl.Pos{Line: 15, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "connections",
+			Content: `
+resource "aws_vpc" "a" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "b" {
+  cidr_block = "10.0.128.0/17"
+}
+
+resource "aws_vpc" "c" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_vpc" "d" {
+  cidr_block = "10.1.0.0/20"
+}
+
+resource "aws_vpc_peering_connection" "ab" {
+  vpc_id      = aws_vpc.a.id
+  peer_vpc_id = aws_vpc.b.id
+}
+
+resource "aws_vpc_peering_connection" "ac" {
+  vpc_id      = aws_vpc.a.id
+  peer_vpc_id = aws_vpc.c.id
+}
+
+resource "aws_ec2_transit_gateway" "main" {}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "c" {
+  vpc_id             = aws_vpc.c.id
+  transit_gateway_id = aws_ec2_transit_gateway.main.id
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "d" {
+  vpc_id             = aws_vpc.d.id
+  transit_gateway_id = aws_ec2_transit_gateway.main.id
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsVpcInvalidCidrBlockRule(),
+					Message: `The CIDR block "10.0.0.0/16" of aws_vpc.a overlaps with "10.0.128.0/17" of aws_vpc.b`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 18, Column: 1},
+						End:      hcl.Pos{Line: 18, Column: 43},
+					},
+				},
+				{
+					Rule:    NewAwsVpcInvalidCidrBlockRule(),
+					Message: `The CIDR block "10.1.0.0/16" of aws_vpc.c overlaps with "10.1.0.0/20" of aws_vpc.d`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 35, Column: 1},
+						End:      hcl.Pos{Line: 35, Column: 54},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAwsVpcInvalidCidrBlockRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/aws"
+	"github.com/terraform-linters/tflint-ruleset-aws/project"
+)
+
+// AwsWafv2InvalidScopeRegionRule checks whether WAFv2 resources for CloudFront are created in us-east-1
+type AwsWafv2InvalidScopeRegionRule struct {
+	tflint.DefaultRule
+
+	resourceTypes []string
+	attributeName string
+}
+
+// NewAwsWafv2InvalidScopeRegionRule returns new rule with default attributes
+func NewAwsWafv2InvalidScopeRegionRule() *AwsWafv2InvalidScopeRegionRule {
+	return &AwsWafv2InvalidScopeRegionRule{
+		resourceTypes: []string{"aws_wafv2_ip_set", "aws_wafv2_regex_pattern_set", "aws_wafv2_rule_group", "aws_wafv2_web_acl"},
+		attributeName: "scope",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsWafv2InvalidScopeRegionRule) Name() string {
+	return "aws_wafv2_invalid_scope_region"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsWafv2InvalidScopeRegionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsWafv2InvalidScopeRegionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsWafv2InvalidScopeRegionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks whether the provider region is us-east-1 if the scope is CLOUDFRONT.
+// Resources whose provider region cannot be determined are skipped.
+func (r *AwsWafv2InvalidScopeRegionRule) Check(runner tflint.Runner) error {
+	regions, err := aws.NewRegionResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, resourceType := range r.resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "provider"}, {Name: r.attributeName}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attribute, exists := resource.Body.Attributes[r.attributeName]
+			if !exists {
+				continue
+			}
+
+			region := regions.ResourceRegion(resource)
+			if region == "" || region == cloudfrontRegion {
+				continue
+			}
+
+			err = runner.EvaluateExpr(attribute.Expr, func(scope string) error {
+				if !strings.EqualFold(scope, "CLOUDFRONT") {
+					return nil
+				}
+
+				return runner.EmitIssue(
+					r,
+					fmt.Sprintf(`The CLOUDFRONT scope is only available in %s, but the provider region is %s`, cloudfrontRegion, region),
+					attribute.Expr.Range(),
+				)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
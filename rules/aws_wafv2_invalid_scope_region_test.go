@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AwsWafv2InvalidScopeRegion(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "CLOUDFRONT scope in another region",
+			Content: `
+provider "aws" {
+  region = "eu-west-1"
+}
+
+resource "aws_wafv2_web_acl" "main" {
+  scope = "CLOUDFRONT"
+}
+`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAwsWafv2InvalidScopeRegionRule(),
+					Message: `The CLOUDFRONT scope is only available in us-east-1, but the provider region is eu-west-1`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 11},
+						End:      hcl.Pos{Line: 7, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "REGIONAL scope",
+			Content: `
+provider "aws" {
+  region = "eu-west-1"
+}
+
+resource "aws_wafv2_ip_set" "main" {
+  scope = "REGIONAL"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "aliased provider in us-east-1",
+			Content: `
+provider "aws" {
+  region = "eu-west-1"
+}
+
+provider "aws" {
+  alias  = "us_east_1"
+  region = "us-east-1"
+}
+
+resource "aws_wafv2_rule_group" "main" {
+  provider = aws.us_east_1
+
+  scope = "CLOUDFRONT"
+}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unknown region",
+			Content: `
+resource "aws_wafv2_regex_pattern_set" "main" {
+  scope = "CLOUDFRONT"
+}
+`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAwsWafv2InvalidScopeRegionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// cloudwatchMetricAlarmSchema is a schema of aws_cloudwatch_metric_alarm shared by the metric alarm rules
+var cloudwatchMetricAlarmSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "comparison_operator"},
+		{Name: "evaluation_periods"},
+		{Name: "period"},
+		{Name: "metric_name"},
+		{Name: "statistic"},
+		{Name: "extended_statistic"},
+		{Name: "threshold"},
+		{Name: "threshold_metric_id"},
+		{Name: "alarm_actions"},
+		{Name: "ok_actions"},
+		{Name: "insufficient_data_actions"},
+		{Name: "provider"},
+	},
+	Blocks: []hclext.BlockSchema{
+		{
+			Type: "metric_query",
+			Body: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{
+					{Name: "id"},
+					{Name: "expression"},
+					{Name: "return_data"},
+					{Name: "period"},
+				},
+				Blocks: []hclext.BlockSchema{
+					{
+						Type: "metric",
+						Body: &hclext.BodySchema{
+							Attributes: []hclext.AttributeSchema{
+								{Name: "metric_name"},
+								{Name: "period"},
+								{Name: "stat"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// isAttributeSet returns whether the attribute is declared and not null.
+// Unknown values are considered to be set.
+func isAttributeSet(runner tflint.Runner, body *hclext.BodyContent, name string) (bool, error) {
+	attribute, exists := body.Attributes[name]
+	if !exists {
+		return false, nil
+	}
+
+	set := false
+	err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
+		set = !val.IsNull()
+		return nil
+	}, nil)
+	return set, err
+}
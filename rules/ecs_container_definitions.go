@@ -0,0 +1,245 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"golang.org/x/exp/maps"
+)
+
+// ecsContainerDefinitionKeys are keys of ContainerDefinition in the ECS API (lowercase)
+// https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_ContainerDefinition.html
+var ecsContainerDefinitionKeys = map[string]bool{
+	"command":                true,
+	"cpu":                    true,
+	"credentialspecs":        true,
+	"dependson":              true,
+	"disablenetworking":      true,
+	"dnssearchdomains":       true,
+	"dnsservers":             true,
+	"dockerlabels":           true,
+	"dockersecurityoptions":  true,
+	"entrypoint":             true,
+	"environment":            true,
+	"environmentfiles":       true,
+	"essential":              true,
+	"extrahosts":             true,
+	"firelensconfiguration":  true,
+	"healthcheck":            true,
+	"hostname":               true,
+	"image":                  true,
+	"interactive":            true,
+	"links":                  true,
+	"linuxparameters":        true,
+	"logconfiguration":       true,
+	"memory":                 true,
+	"memoryreservation":      true,
+	"mountpoints":            true,
+	"name":                   true,
+	"portmappings":           true,
+	"privileged":             true,
+	"pseudoterminal":         true,
+	"readonlyrootfilesystem": true,
+	"repositorycredentials":  true,
+	"resourcerequirements":   true,
+	"restartpolicy":          true,
+	"secrets":                true,
+	"starttimeout":           true,
+	"stoptimeout":            true,
+	"systemcontrols":         true,
+	"ulimits":                true,
+	"user":                   true,
+	"versionconsistency":     true,
+	"volumesfrom":            true,
+	"workingdirectory":       true,
+}
+
+// ecsContainerDefinition is a container definition in `container_definitions`.
+// Keys are matched case-insensitively like the AWS provider does.
+type ecsContainerDefinition struct {
+	Name                   string                    `json:"name"`
+	CPU                    ecsInt                    `json:"cpu"`
+	Memory                 *ecsInt                   `json:"memory"`
+	MemoryReservation      *ecsInt                   `json:"memoryReservation"`
+	Privileged             *ecsBool                  `json:"privileged"`
+	ReadonlyRootFilesystem *ecsBool                  `json:"readonlyRootFilesystem"`
+	Environment            []ecsKeyValuePair         `json:"environment"`
+	PortMappings           []ecsContainerPortMapping `json:"portMappings"`
+
+	// Keys are the top-level keys declared in the definition
+	Keys []string `json:"-"`
+}
+
+type ecsKeyValuePair struct {
+	Name  ecsString `json:"name"`
+	Value ecsString `json:"value"`
+}
+
+type ecsContainerPortMapping struct {
+	ContainerPort *ecsInt `json:"containerPort"`
+	HostPort      *ecsInt `json:"hostPort"`
+}
+
+// ecsInt is an integer that can also be declared as a numeric string like "256"
+type ecsInt int
+
+// UnmarshalJSON accepts a number or a string of an integer
+func (i *ecsInt) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch val := raw.(type) {
+	case float64:
+		*i = ecsInt(val)
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil {
+			return fmt.Errorf(`"%s" is not an integer`, val)
+		}
+		*i = ecsInt(n)
+	case nil:
+		// Same as omitted
+	default:
+		return fmt.Errorf("%s is not an integer", string(data))
+	}
+	return nil
+}
+
+// ecsBool is a boolean that can also be declared as a string like "true"
+type ecsBool bool
+
+// UnmarshalJSON accepts a boolean or a string of a boolean
+func (b *ecsBool) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch val := raw.(type) {
+	case bool:
+		*b = ecsBool(val)
+	case string:
+		parsed, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf(`"%s" is not a boolean`, val)
+		}
+		*b = ecsBool(parsed)
+	case nil:
+		// Same as omitted
+	default:
+		return fmt.Errorf("%s is not a boolean", string(data))
+	}
+	return nil
+}
+
+// ecsString is a string that can also be declared as a number or a boolean like environment values
+type ecsString string
+
+// UnmarshalJSON accepts a string, a number or a boolean
+func (s *ecsString) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch val := raw.(type) {
+	case string:
+		*s = ecsString(val)
+	case float64:
+		*s = ecsString(strconv.FormatFloat(val, 'f', -1, 64))
+	case bool:
+		*s = ecsString(strconv.FormatBool(val))
+	case nil:
+		// Same as omitted
+	default:
+		return fmt.Errorf("%s is not a string", string(data))
+	}
+	return nil
+}
+
+// parseECSContainerDefinitions parses a JSON array of container definitions
+func parseECSContainerDefinitions(src string) ([]*ecsContainerDefinition, error) {
+	raw := []map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(src), &raw); err != nil {
+		return nil, err
+	}
+
+	definitions := []*ecsContainerDefinition{}
+	if err := json.Unmarshal([]byte(src), &definitions); err != nil {
+		return nil, err
+	}
+
+	for i, definition := range definitions {
+		if definition == nil {
+			return nil, fmt.Errorf("the container definition at index %d is null", i)
+		}
+
+		keys := maps.Keys(raw[i])
+		sort.Strings(keys)
+		definition.Keys = keys
+	}
+	return definitions, nil
+}
+
+// ecsTaskDefinitionSchema is a schema of attributes of aws_ecs_task_definition that container definitions depend on
+var ecsTaskDefinitionSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "container_definitions"},
+		{Name: "cpu"},
+		{Name: "memory"},
+		{Name: "network_mode"},
+	},
+}
+
+// walkECSContainerDefinitions visits container definitions of every aws_ecs_task_definition.
+// If container_definitions is not valid JSON, the walker is called with a parse error.
+func walkECSContainerDefinitions(runner tflint.Runner, walker func(resource *hclext.Block, attribute *hclext.Attribute, definitions []*ecsContainerDefinition, err error) error) error {
+	resources, err := runner.GetResourceContent("aws_ecs_task_definition", ecsTaskDefinitionSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes["container_definitions"]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(src string) error {
+			definitions, err := parseECSContainerDefinitions(src)
+			return walker(resource, attribute, definitions, err)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseECSTaskSize converts task-level cpu and memory to CPU units and MiB.
+// Values such as "1 vCPU" and "2 GB" are converted to 1024 and 2048.
+func parseECSTaskSize(val string, unit string) (int, error) {
+	val = strings.TrimSpace(val)
+	fields := strings.Fields(val)
+	if len(fields) == 2 && strings.EqualFold(fields[1], unit) {
+		size, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, err
+		}
+		return int(size * 1024), nil
+	}
+
+	size, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf(`"%s" is not a number`, val)
+	}
+	return size, nil
+}
@@ -0,0 +1,4811 @@
+// Code generated by generator/main.go; DO NOT EDIT.
+package iam
+
+var services = map[string]*Service{
+	"cloudwatch": {
+		Name:          "Amazon CloudWatch",
+		Prefix:        "cloudwatch",
+		ConditionKeys: []string{"cloudwatch:AlarmActions", "cloudwatch:namespace", "cloudwatch:requestInsightRuleLogGroups"},
+		Actions: map[string]*Action{
+			"deletealarms": {
+				Name: "DeleteAlarms",
+			},
+			"deleteanomalydetector": {
+				Name: "DeleteAnomalyDetector",
+			},
+			"deletedashboards": {
+				Name: "DeleteDashboards",
+			},
+			"deleteinsightrules": {
+				Name: "DeleteInsightRules",
+			},
+			"deletemetricstream": {
+				Name: "DeleteMetricStream",
+			},
+			"describealarmhistory": {
+				Name: "DescribeAlarmHistory",
+			},
+			"describealarms": {
+				Name: "DescribeAlarms",
+			},
+			"describealarmsformetric": {
+				Name: "DescribeAlarmsForMetric",
+			},
+			"describeanomalydetectors": {
+				Name: "DescribeAnomalyDetectors",
+			},
+			"describeinsightrules": {
+				Name: "DescribeInsightRules",
+			},
+			"disablealarmactions": {
+				Name: "DisableAlarmActions",
+			},
+			"disableinsightrules": {
+				Name: "DisableInsightRules",
+			},
+			"enablealarmactions": {
+				Name: "EnableAlarmActions",
+			},
+			"enableinsightrules": {
+				Name: "EnableInsightRules",
+			},
+			"getdashboard": {
+				Name: "GetDashboard",
+			},
+			"getinsightrulereport": {
+				Name: "GetInsightRuleReport",
+			},
+			"getmetricdata": {
+				Name: "GetMetricData",
+			},
+			"getmetricstatistics": {
+				Name: "GetMetricStatistics",
+			},
+			"getmetricstream": {
+				Name: "GetMetricStream",
+			},
+			"getmetricwidgetimage": {
+				Name: "GetMetricWidgetImage",
+			},
+			"listdashboards": {
+				Name: "ListDashboards",
+			},
+			"listmanagedinsightrules": {
+				Name: "ListManagedInsightRules",
+			},
+			"listmetricstreams": {
+				Name: "ListMetricStreams",
+			},
+			"listmetrics": {
+				Name: "ListMetrics",
+			},
+			"listtagsforresource": {
+				Name: "ListTagsForResource",
+			},
+			"putanomalydetector": {
+				Name: "PutAnomalyDetector",
+			},
+			"putcompositealarm": {
+				Name: "PutCompositeAlarm",
+			},
+			"putdashboard": {
+				Name: "PutDashboard",
+			},
+			"putinsightrule": {
+				Name: "PutInsightRule",
+			},
+			"putmanagedinsightrules": {
+				Name: "PutManagedInsightRules",
+			},
+			"putmetricalarm": {
+				Name:          "PutMetricAlarm",
+				ResourceTypes: []string{"alarm"},
+				ConditionKeys: []string{"cloudwatch:AlarmActions", "aws:RequestTag/${TagKey}", "aws:TagKeys"},
+			},
+			"putmetricdata": {
+				Name:          "PutMetricData",
+				ConditionKeys: []string{"cloudwatch:namespace"},
+			},
+			"putmetricstream": {
+				Name: "PutMetricStream",
+			},
+			"setalarmstate": {
+				Name: "SetAlarmState",
+			},
+			"startmetricstreams": {
+				Name: "StartMetricStreams",
+			},
+			"stopmetricstreams": {
+				Name: "StopMetricStreams",
+			},
+			"tagresource": {
+				Name: "TagResource",
+			},
+			"untagresource": {
+				Name: "UntagResource",
+			},
+		},
+	},
+	"dynamodb": {
+		Name:          "Amazon DynamoDB",
+		Prefix:        "dynamodb",
+		ConditionKeys: []string{"dynamodb:Attributes", "dynamodb:EnclosingOperation", "dynamodb:FullTableScan", "dynamodb:LeadingKeys", "dynamodb:ReturnConsumedCapacity", "dynamodb:ReturnValues", "dynamodb:Select"},
+		Actions: map[string]*Action{
+			"batchexecutestatement": {
+				Name: "BatchExecuteStatement",
+			},
+			"batchgetitem": {
+				Name: "BatchGetItem",
+			},
+			"batchwriteitem": {
+				Name: "BatchWriteItem",
+			},
+			"conditioncheckitem": {
+				Name: "ConditionCheckItem",
+			},
+			"createbackup": {
+				Name: "CreateBackup",
+			},
+			"createglobaltable": {
+				Name: "CreateGlobalTable",
+			},
+			"createtable": {
+				Name: "CreateTable",
+			},
+			"deletebackup": {
+				Name: "DeleteBackup",
+			},
+			"deleteitem": {
+				Name: "DeleteItem",
+			},
+			"deletetable": {
+				Name: "DeleteTable",
+			},
+			"describebackup": {
+				Name: "DescribeBackup",
+			},
+			"describecontinuousbackups": {
+				Name: "DescribeContinuousBackups",
+			},
+			"describecontributorinsights": {
+				Name: "DescribeContributorInsights",
+			},
+			"describeendpoints": {
+				Name: "DescribeEndpoints",
+			},
+			"describeexport": {
+				Name: "DescribeExport",
+			},
+			"describeglobaltable": {
+				Name: "DescribeGlobalTable",
+			},
+			"describeglobaltablesettings": {
+				Name: "DescribeGlobalTableSettings",
+			},
+			"describeimport": {
+				Name: "DescribeImport",
+			},
+			"describekinesisstreamingdestination": {
+				Name: "DescribeKinesisStreamingDestination",
+			},
+			"describelimits": {
+				Name: "DescribeLimits",
+			},
+			"describestream": {
+				Name: "DescribeStream",
+			},
+			"describetable": {
+				Name: "DescribeTable",
+			},
+			"describetablereplicaautoscaling": {
+				Name: "DescribeTableReplicaAutoScaling",
+			},
+			"describetimetolive": {
+				Name: "DescribeTimeToLive",
+			},
+			"disablekinesisstreamingdestination": {
+				Name: "DisableKinesisStreamingDestination",
+			},
+			"enablekinesisstreamingdestination": {
+				Name: "EnableKinesisStreamingDestination",
+			},
+			"executestatement": {
+				Name: "ExecuteStatement",
+			},
+			"executetransaction": {
+				Name: "ExecuteTransaction",
+			},
+			"exporttabletopointintime": {
+				Name: "ExportTableToPointInTime",
+			},
+			"getitem": {
+				Name:          "GetItem",
+				ResourceTypes: []string{"table"},
+				ConditionKeys: []string{"dynamodb:Attributes", "dynamodb:LeadingKeys", "dynamodb:Select"},
+			},
+			"getrecords": {
+				Name: "GetRecords",
+			},
+			"getsharditerator": {
+				Name: "GetShardIterator",
+			},
+			"importtable": {
+				Name: "ImportTable",
+			},
+			"listbackups": {
+				Name: "ListBackups",
+			},
+			"listcontributorinsights": {
+				Name: "ListContributorInsights",
+			},
+			"listexports": {
+				Name: "ListExports",
+			},
+			"listglobaltables": {
+				Name: "ListGlobalTables",
+			},
+			"listimports": {
+				Name: "ListImports",
+			},
+			"liststreams": {
+				Name: "ListStreams",
+			},
+			"listtables": {
+				Name: "ListTables",
+			},
+			"listtagsofresource": {
+				Name: "ListTagsOfResource",
+			},
+			"partiqldelete": {
+				Name: "PartiQLDelete",
+			},
+			"partiqlinsert": {
+				Name: "PartiQLInsert",
+			},
+			"partiqlselect": {
+				Name: "PartiQLSelect",
+			},
+			"partiqlupdate": {
+				Name: "PartiQLUpdate",
+			},
+			"putitem": {
+				Name:          "PutItem",
+				ResourceTypes: []string{"table"},
+				ConditionKeys: []string{"dynamodb:Attributes", "dynamodb:LeadingKeys", "dynamodb:ReturnValues"},
+			},
+			"query": {
+				Name:          "Query",
+				ResourceTypes: []string{"index", "table"},
+				ConditionKeys: []string{"dynamodb:Attributes", "dynamodb:LeadingKeys", "dynamodb:Select"},
+			},
+			"restoretablefromawsbackup": {
+				Name: "RestoreTableFromAwsBackup",
+			},
+			"restoretablefrombackup": {
+				Name: "RestoreTableFromBackup",
+			},
+			"restoretabletopointintime": {
+				Name: "RestoreTableToPointInTime",
+			},
+			"scan": {
+				Name:          "Scan",
+				ResourceTypes: []string{"index", "table"},
+				ConditionKeys: []string{"dynamodb:Attributes", "dynamodb:Select"},
+			},
+			"startawsbackupjob": {
+				Name: "StartAwsBackupJob",
+			},
+			"tagresource": {
+				Name: "TagResource",
+			},
+			"transactgetitems": {
+				Name: "TransactGetItems",
+			},
+			"transactwriteitems": {
+				Name: "TransactWriteItems",
+			},
+			"untagresource": {
+				Name: "UntagResource",
+			},
+			"updatecontinuousbackups": {
+				Name: "UpdateContinuousBackups",
+			},
+			"updatecontributorinsights": {
+				Name: "UpdateContributorInsights",
+			},
+			"updateglobaltable": {
+				Name: "UpdateGlobalTable",
+			},
+			"updateglobaltablesettings": {
+				Name: "UpdateGlobalTableSettings",
+			},
+			"updateitem": {
+				Name: "UpdateItem",
+			},
+			"updatetable": {
+				Name: "UpdateTable",
+			},
+			"updatetablereplicaautoscaling": {
+				Name: "UpdateTableReplicaAutoScaling",
+			},
+			"updatetimetolive": {
+				Name: "UpdateTimeToLive",
+			},
+		},
+	},
+	"ec2": {
+		Name:          "Amazon Elastic Compute Cloud",
+		Prefix:        "ec2",
+		ConditionKeys: []string{"ec2:AvailabilityZone", "ec2:InstanceType", "ec2:Region", "ec2:ResourceTag/${TagKey}", "ec2:Tenancy", "ec2:Vpc", "ec2:Subnet", "ec2:MetadataHttpTokens", "ec2:CreateAction"},
+		Actions: map[string]*Action{
+			"acceptaddresstransfer": {
+				Name: "AcceptAddressTransfer",
+			},
+			"acceptreservedinstancesexchangequote": {
+				Name: "AcceptReservedInstancesExchangeQuote",
+			},
+			"accepttransitgatewaymulticastdomainassociations": {
+				Name: "AcceptTransitGatewayMulticastDomainAssociations",
+			},
+			"accepttransitgatewaypeeringattachment": {
+				Name: "AcceptTransitGatewayPeeringAttachment",
+			},
+			"accepttransitgatewayvpcattachment": {
+				Name: "AcceptTransitGatewayVpcAttachment",
+			},
+			"acceptvpcendpointconnections": {
+				Name: "AcceptVpcEndpointConnections",
+			},
+			"acceptvpcpeeringconnection": {
+				Name: "AcceptVpcPeeringConnection",
+			},
+			"advertisebyoipcidr": {
+				Name: "AdvertiseByoipCidr",
+			},
+			"allocateaddress": {
+				Name: "AllocateAddress",
+			},
+			"allocatehosts": {
+				Name: "AllocateHosts",
+			},
+			"allocateipampoolcidr": {
+				Name: "AllocateIpamPoolCidr",
+			},
+			"applysecuritygroupstoclientvpntargetnetwork": {
+				Name: "ApplySecurityGroupsToClientVpnTargetNetwork",
+			},
+			"assignipv6addresses": {
+				Name: "AssignIpv6Addresses",
+			},
+			"assignprivateipaddresses": {
+				Name: "AssignPrivateIpAddresses",
+			},
+			"assignprivatenatgatewayaddress": {
+				Name: "AssignPrivateNatGatewayAddress",
+			},
+			"associateaddress": {
+				Name: "AssociateAddress",
+			},
+			"associateclientvpntargetnetwork": {
+				Name: "AssociateClientVpnTargetNetwork",
+			},
+			"associatedhcpoptions": {
+				Name: "AssociateDhcpOptions",
+			},
+			"associateenclavecertificateiamrole": {
+				Name: "AssociateEnclaveCertificateIamRole",
+			},
+			"associateiaminstanceprofile": {
+				Name: "AssociateIamInstanceProfile",
+			},
+			"associateinstanceeventwindow": {
+				Name: "AssociateInstanceEventWindow",
+			},
+			"associateipamresourcediscovery": {
+				Name: "AssociateIpamResourceDiscovery",
+			},
+			"associatenatgatewayaddress": {
+				Name: "AssociateNatGatewayAddress",
+			},
+			"associateroutetable": {
+				Name: "AssociateRouteTable",
+			},
+			"associatesubnetcidrblock": {
+				Name: "AssociateSubnetCidrBlock",
+			},
+			"associatetransitgatewaymulticastdomain": {
+				Name: "AssociateTransitGatewayMulticastDomain",
+			},
+			"associatetransitgatewaypolicytable": {
+				Name: "AssociateTransitGatewayPolicyTable",
+			},
+			"associatetransitgatewayroutetable": {
+				Name: "AssociateTransitGatewayRouteTable",
+			},
+			"associatetrunkinterface": {
+				Name: "AssociateTrunkInterface",
+			},
+			"associatevpccidrblock": {
+				Name: "AssociateVpcCidrBlock",
+			},
+			"attachclassiclinkvpc": {
+				Name: "AttachClassicLinkVpc",
+			},
+			"attachinternetgateway": {
+				Name: "AttachInternetGateway",
+			},
+			"attachnetworkinterface": {
+				Name: "AttachNetworkInterface",
+			},
+			"attachverifiedaccesstrustprovider": {
+				Name: "AttachVerifiedAccessTrustProvider",
+			},
+			"attachvolume": {
+				Name: "AttachVolume",
+			},
+			"attachvpngateway": {
+				Name: "AttachVpnGateway",
+			},
+			"authorizeclientvpningress": {
+				Name: "AuthorizeClientVpnIngress",
+			},
+			"authorizesecuritygroupegress": {
+				Name: "AuthorizeSecurityGroupEgress",
+			},
+			"authorizesecuritygroupingress": {
+				Name: "AuthorizeSecurityGroupIngress",
+			},
+			"bundleinstance": {
+				Name: "BundleInstance",
+			},
+			"cancelbundletask": {
+				Name: "CancelBundleTask",
+			},
+			"cancelcapacityreservation": {
+				Name: "CancelCapacityReservation",
+			},
+			"cancelcapacityreservationfleets": {
+				Name: "CancelCapacityReservationFleets",
+			},
+			"cancelconversiontask": {
+				Name: "CancelConversionTask",
+			},
+			"cancelexporttask": {
+				Name: "CancelExportTask",
+			},
+			"cancelimagelaunchpermission": {
+				Name: "CancelImageLaunchPermission",
+			},
+			"cancelimporttask": {
+				Name: "CancelImportTask",
+			},
+			"cancelreservedinstanceslisting": {
+				Name: "CancelReservedInstancesListing",
+			},
+			"cancelspotfleetrequests": {
+				Name: "CancelSpotFleetRequests",
+			},
+			"cancelspotinstancerequests": {
+				Name: "CancelSpotInstanceRequests",
+			},
+			"confirmproductinstance": {
+				Name: "ConfirmProductInstance",
+			},
+			"copyfpgaimage": {
+				Name: "CopyFpgaImage",
+			},
+			"copyimage": {
+				Name: "CopyImage",
+			},
+			"copysnapshot": {
+				Name: "CopySnapshot",
+			},
+			"createcapacityreservation": {
+				Name: "CreateCapacityReservation",
+			},
+			"createcapacityreservationfleet": {
+				Name: "CreateCapacityReservationFleet",
+			},
+			"createcarriergateway": {
+				Name: "CreateCarrierGateway",
+			},
+			"createclientvpnendpoint": {
+				Name: "CreateClientVpnEndpoint",
+			},
+			"createclientvpnroute": {
+				Name: "CreateClientVpnRoute",
+			},
+			"createcoipcidr": {
+				Name: "CreateCoipCidr",
+			},
+			"createcoippool": {
+				Name: "CreateCoipPool",
+			},
+			"createcustomergateway": {
+				Name: "CreateCustomerGateway",
+			},
+			"createdefaultsubnet": {
+				Name: "CreateDefaultSubnet",
+			},
+			"createdefaultvpc": {
+				Name: "CreateDefaultVpc",
+			},
+			"createdhcpoptions": {
+				Name: "CreateDhcpOptions",
+			},
+			"createegressonlyinternetgateway": {
+				Name: "CreateEgressOnlyInternetGateway",
+			},
+			"createfleet": {
+				Name: "CreateFleet",
+			},
+			"createflowlogs": {
+				Name: "CreateFlowLogs",
+			},
+			"createfpgaimage": {
+				Name: "CreateFpgaImage",
+			},
+			"createimage": {
+				Name: "CreateImage",
+			},
+			"createinstanceconnectendpoint": {
+				Name: "CreateInstanceConnectEndpoint",
+			},
+			"createinstanceeventwindow": {
+				Name: "CreateInstanceEventWindow",
+			},
+			"createinstanceexporttask": {
+				Name: "CreateInstanceExportTask",
+			},
+			"createinternetgateway": {
+				Name: "CreateInternetGateway",
+			},
+			"createipam": {
+				Name: "CreateIpam",
+			},
+			"createipampool": {
+				Name: "CreateIpamPool",
+			},
+			"createipamresourcediscovery": {
+				Name: "CreateIpamResourceDiscovery",
+			},
+			"createipamscope": {
+				Name: "CreateIpamScope",
+			},
+			"createkeypair": {
+				Name: "CreateKeyPair",
+			},
+			"createlaunchtemplate": {
+				Name: "CreateLaunchTemplate",
+			},
+			"createlaunchtemplateversion": {
+				Name: "CreateLaunchTemplateVersion",
+			},
+			"createlocalgatewayroute": {
+				Name: "CreateLocalGatewayRoute",
+			},
+			"createlocalgatewayroutetable": {
+				Name: "CreateLocalGatewayRouteTable",
+			},
+			"createlocalgatewayroutetablevirtualinterfacegroupassociation": {
+				Name: "CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociation",
+			},
+			"createlocalgatewayroutetablevpcassociation": {
+				Name: "CreateLocalGatewayRouteTableVpcAssociation",
+			},
+			"createmanagedprefixlist": {
+				Name: "CreateManagedPrefixList",
+			},
+			"createnatgateway": {
+				Name: "CreateNatGateway",
+			},
+			"createnetworkacl": {
+				Name: "CreateNetworkAcl",
+			},
+			"createnetworkaclentry": {
+				Name: "CreateNetworkAclEntry",
+			},
+			"createnetworkinsightsaccessscope": {
+				Name: "CreateNetworkInsightsAccessScope",
+			},
+			"createnetworkinsightspath": {
+				Name: "CreateNetworkInsightsPath",
+			},
+			"createnetworkinterface": {
+				Name: "CreateNetworkInterface",
+			},
+			"createnetworkinterfacepermission": {
+				Name: "CreateNetworkInterfacePermission",
+			},
+			"createplacementgroup": {
+				Name: "CreatePlacementGroup",
+			},
+			"createpublicipv4pool": {
+				Name: "CreatePublicIpv4Pool",
+			},
+			"createreplacerootvolumetask": {
+				Name: "CreateReplaceRootVolumeTask",
+			},
+			"createreservedinstanceslisting": {
+				Name: "CreateReservedInstancesListing",
+			},
+			"createrestoreimagetask": {
+				Name: "CreateRestoreImageTask",
+			},
+			"createroute": {
+				Name: "CreateRoute",
+			},
+			"createroutetable": {
+				Name: "CreateRouteTable",
+			},
+			"createsecuritygroup": {
+				Name: "CreateSecurityGroup",
+			},
+			"createsnapshot": {
+				Name: "CreateSnapshot",
+			},
+			"createsnapshots": {
+				Name: "CreateSnapshots",
+			},
+			"createspotdatafeedsubscription": {
+				Name: "CreateSpotDatafeedSubscription",
+			},
+			"createstoreimagetask": {
+				Name: "CreateStoreImageTask",
+			},
+			"createsubnet": {
+				Name: "CreateSubnet",
+			},
+			"createsubnetcidrreservation": {
+				Name: "CreateSubnetCidrReservation",
+			},
+			"createtags": {
+				Name:          "CreateTags",
+				ResourceTypes: []string{"*"},
+				ConditionKeys: []string{"ec2:CreateAction", "aws:RequestTag/${TagKey}", "aws:TagKeys"},
+			},
+			"createtrafficmirrorfilter": {
+				Name: "CreateTrafficMirrorFilter",
+			},
+			"createtrafficmirrorfilterrule": {
+				Name: "CreateTrafficMirrorFilterRule",
+			},
+			"createtrafficmirrorsession": {
+				Name: "CreateTrafficMirrorSession",
+			},
+			"createtrafficmirrortarget": {
+				Name: "CreateTrafficMirrorTarget",
+			},
+			"createtransitgateway": {
+				Name: "CreateTransitGateway",
+			},
+			"createtransitgatewayconnect": {
+				Name: "CreateTransitGatewayConnect",
+			},
+			"createtransitgatewayconnectpeer": {
+				Name: "CreateTransitGatewayConnectPeer",
+			},
+			"createtransitgatewaymulticastdomain": {
+				Name: "CreateTransitGatewayMulticastDomain",
+			},
+			"createtransitgatewaypeeringattachment": {
+				Name: "CreateTransitGatewayPeeringAttachment",
+			},
+			"createtransitgatewaypolicytable": {
+				Name: "CreateTransitGatewayPolicyTable",
+			},
+			"createtransitgatewayprefixlistreference": {
+				Name: "CreateTransitGatewayPrefixListReference",
+			},
+			"createtransitgatewayroute": {
+				Name: "CreateTransitGatewayRoute",
+			},
+			"createtransitgatewayroutetable": {
+				Name: "CreateTransitGatewayRouteTable",
+			},
+			"createtransitgatewayroutetableannouncement": {
+				Name: "CreateTransitGatewayRouteTableAnnouncement",
+			},
+			"createtransitgatewayvpcattachment": {
+				Name: "CreateTransitGatewayVpcAttachment",
+			},
+			"createverifiedaccessendpoint": {
+				Name: "CreateVerifiedAccessEndpoint",
+			},
+			"createverifiedaccessgroup": {
+				Name: "CreateVerifiedAccessGroup",
+			},
+			"createverifiedaccessinstance": {
+				Name: "CreateVerifiedAccessInstance",
+			},
+			"createverifiedaccesstrustprovider": {
+				Name: "CreateVerifiedAccessTrustProvider",
+			},
+			"createvolume": {
+				Name: "CreateVolume",
+			},
+			"createvpc": {
+				Name: "CreateVpc",
+			},
+			"createvpcendpoint": {
+				Name: "CreateVpcEndpoint",
+			},
+			"createvpcendpointconnectionnotification": {
+				Name: "CreateVpcEndpointConnectionNotification",
+			},
+			"createvpcendpointserviceconfiguration": {
+				Name: "CreateVpcEndpointServiceConfiguration",
+			},
+			"createvpcpeeringconnection": {
+				Name: "CreateVpcPeeringConnection",
+			},
+			"createvpnconnection": {
+				Name: "CreateVpnConnection",
+			},
+			"createvpnconnectionroute": {
+				Name: "CreateVpnConnectionRoute",
+			},
+			"createvpngateway": {
+				Name: "CreateVpnGateway",
+			},
+			"deletecarriergateway": {
+				Name: "DeleteCarrierGateway",
+			},
+			"deleteclientvpnendpoint": {
+				Name: "DeleteClientVpnEndpoint",
+			},
+			"deleteclientvpnroute": {
+				Name: "DeleteClientVpnRoute",
+			},
+			"deletecoipcidr": {
+				Name: "DeleteCoipCidr",
+			},
+			"deletecoippool": {
+				Name: "DeleteCoipPool",
+			},
+			"deletecustomergateway": {
+				Name: "DeleteCustomerGateway",
+			},
+			"deletedhcpoptions": {
+				Name: "DeleteDhcpOptions",
+			},
+			"deleteegressonlyinternetgateway": {
+				Name: "DeleteEgressOnlyInternetGateway",
+			},
+			"deletefleets": {
+				Name: "DeleteFleets",
+			},
+			"deleteflowlogs": {
+				Name: "DeleteFlowLogs",
+			},
+			"deletefpgaimage": {
+				Name: "DeleteFpgaImage",
+			},
+			"deleteinstanceconnectendpoint": {
+				Name: "DeleteInstanceConnectEndpoint",
+			},
+			"deleteinstanceeventwindow": {
+				Name: "DeleteInstanceEventWindow",
+			},
+			"deleteinternetgateway": {
+				Name: "DeleteInternetGateway",
+			},
+			"deleteipam": {
+				Name: "DeleteIpam",
+			},
+			"deleteipampool": {
+				Name: "DeleteIpamPool",
+			},
+			"deleteipamresourcediscovery": {
+				Name: "DeleteIpamResourceDiscovery",
+			},
+			"deleteipamscope": {
+				Name: "DeleteIpamScope",
+			},
+			"deletekeypair": {
+				Name: "DeleteKeyPair",
+			},
+			"deletelaunchtemplate": {
+				Name: "DeleteLaunchTemplate",
+			},
+			"deletelaunchtemplateversions": {
+				Name: "DeleteLaunchTemplateVersions",
+			},
+			"deletelocalgatewayroute": {
+				Name: "DeleteLocalGatewayRoute",
+			},
+			"deletelocalgatewayroutetable": {
+				Name: "DeleteLocalGatewayRouteTable",
+			},
+			"deletelocalgatewayroutetablevirtualinterfacegroupassociation": {
+				Name: "DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociation",
+			},
+			"deletelocalgatewayroutetablevpcassociation": {
+				Name: "DeleteLocalGatewayRouteTableVpcAssociation",
+			},
+			"deletemanagedprefixlist": {
+				Name: "DeleteManagedPrefixList",
+			},
+			"deletenatgateway": {
+				Name: "DeleteNatGateway",
+			},
+			"deletenetworkacl": {
+				Name: "DeleteNetworkAcl",
+			},
+			"deletenetworkaclentry": {
+				Name: "DeleteNetworkAclEntry",
+			},
+			"deletenetworkinsightsaccessscope": {
+				Name: "DeleteNetworkInsightsAccessScope",
+			},
+			"deletenetworkinsightsaccessscopeanalysis": {
+				Name: "DeleteNetworkInsightsAccessScopeAnalysis",
+			},
+			"deletenetworkinsightsanalysis": {
+				Name: "DeleteNetworkInsightsAnalysis",
+			},
+			"deletenetworkinsightspath": {
+				Name: "DeleteNetworkInsightsPath",
+			},
+			"deletenetworkinterface": {
+				Name: "DeleteNetworkInterface",
+			},
+			"deletenetworkinterfacepermission": {
+				Name: "DeleteNetworkInterfacePermission",
+			},
+			"deleteplacementgroup": {
+				Name: "DeletePlacementGroup",
+			},
+			"deletepublicipv4pool": {
+				Name: "DeletePublicIpv4Pool",
+			},
+			"deletequeuedreservedinstances": {
+				Name: "DeleteQueuedReservedInstances",
+			},
+			"deleteroute": {
+				Name: "DeleteRoute",
+			},
+			"deleteroutetable": {
+				Name: "DeleteRouteTable",
+			},
+			"deletesecuritygroup": {
+				Name: "DeleteSecurityGroup",
+			},
+			"deletesnapshot": {
+				Name: "DeleteSnapshot",
+			},
+			"deletespotdatafeedsubscription": {
+				Name: "DeleteSpotDatafeedSubscription",
+			},
+			"deletesubnet": {
+				Name: "DeleteSubnet",
+			},
+			"deletesubnetcidrreservation": {
+				Name: "DeleteSubnetCidrReservation",
+			},
+			"deletetags": {
+				Name: "DeleteTags",
+			},
+			"deletetrafficmirrorfilter": {
+				Name: "DeleteTrafficMirrorFilter",
+			},
+			"deletetrafficmirrorfilterrule": {
+				Name: "DeleteTrafficMirrorFilterRule",
+			},
+			"deletetrafficmirrorsession": {
+				Name: "DeleteTrafficMirrorSession",
+			},
+			"deletetrafficmirrortarget": {
+				Name: "DeleteTrafficMirrorTarget",
+			},
+			"deletetransitgateway": {
+				Name: "DeleteTransitGateway",
+			},
+			"deletetransitgatewayconnect": {
+				Name: "DeleteTransitGatewayConnect",
+			},
+			"deletetransitgatewayconnectpeer": {
+				Name: "DeleteTransitGatewayConnectPeer",
+			},
+			"deletetransitgatewaymulticastdomain": {
+				Name: "DeleteTransitGatewayMulticastDomain",
+			},
+			"deletetransitgatewaypeeringattachment": {
+				Name: "DeleteTransitGatewayPeeringAttachment",
+			},
+			"deletetransitgatewaypolicytable": {
+				Name: "DeleteTransitGatewayPolicyTable",
+			},
+			"deletetransitgatewayprefixlistreference": {
+				Name: "DeleteTransitGatewayPrefixListReference",
+			},
+			"deletetransitgatewayroute": {
+				Name: "DeleteTransitGatewayRoute",
+			},
+			"deletetransitgatewayroutetable": {
+				Name: "DeleteTransitGatewayRouteTable",
+			},
+			"deletetransitgatewayroutetableannouncement": {
+				Name: "DeleteTransitGatewayRouteTableAnnouncement",
+			},
+			"deletetransitgatewayvpcattachment": {
+				Name: "DeleteTransitGatewayVpcAttachment",
+			},
+			"deleteverifiedaccessendpoint": {
+				Name: "DeleteVerifiedAccessEndpoint",
+			},
+			"deleteverifiedaccessgroup": {
+				Name: "DeleteVerifiedAccessGroup",
+			},
+			"deleteverifiedaccessinstance": {
+				Name: "DeleteVerifiedAccessInstance",
+			},
+			"deleteverifiedaccesstrustprovider": {
+				Name: "DeleteVerifiedAccessTrustProvider",
+			},
+			"deletevolume": {
+				Name: "DeleteVolume",
+			},
+			"deletevpc": {
+				Name: "DeleteVpc",
+			},
+			"deletevpcendpointconnectionnotifications": {
+				Name: "DeleteVpcEndpointConnectionNotifications",
+			},
+			"deletevpcendpointserviceconfigurations": {
+				Name: "DeleteVpcEndpointServiceConfigurations",
+			},
+			"deletevpcendpoints": {
+				Name: "DeleteVpcEndpoints",
+			},
+			"deletevpcpeeringconnection": {
+				Name: "DeleteVpcPeeringConnection",
+			},
+			"deletevpnconnection": {
+				Name: "DeleteVpnConnection",
+			},
+			"deletevpnconnectionroute": {
+				Name: "DeleteVpnConnectionRoute",
+			},
+			"deletevpngateway": {
+				Name: "DeleteVpnGateway",
+			},
+			"deprovisionbyoipcidr": {
+				Name: "DeprovisionByoipCidr",
+			},
+			"deprovisionipampoolcidr": {
+				Name: "DeprovisionIpamPoolCidr",
+			},
+			"deprovisionpublicipv4poolcidr": {
+				Name: "DeprovisionPublicIpv4PoolCidr",
+			},
+			"deregisterimage": {
+				Name: "DeregisterImage",
+			},
+			"deregisterinstanceeventnotificationattributes": {
+				Name: "DeregisterInstanceEventNotificationAttributes",
+			},
+			"deregistertransitgatewaymulticastgroupmembers": {
+				Name: "DeregisterTransitGatewayMulticastGroupMembers",
+			},
+			"deregistertransitgatewaymulticastgroupsources": {
+				Name: "DeregisterTransitGatewayMulticastGroupSources",
+			},
+			"describeaccountattributes": {
+				Name: "DescribeAccountAttributes",
+			},
+			"describeaddresstransfers": {
+				Name: "DescribeAddressTransfers",
+			},
+			"describeaddresses": {
+				Name: "DescribeAddresses",
+			},
+			"describeaddressesattribute": {
+				Name: "DescribeAddressesAttribute",
+			},
+			"describeaggregateidformat": {
+				Name: "DescribeAggregateIdFormat",
+			},
+			"describeavailabilityzones": {
+				Name: "DescribeAvailabilityZones",
+			},
+			"describeawsnetworkperformancemetricsubscriptions": {
+				Name: "DescribeAwsNetworkPerformanceMetricSubscriptions",
+			},
+			"describebundletasks": {
+				Name: "DescribeBundleTasks",
+			},
+			"describebyoipcidrs": {
+				Name: "DescribeByoipCidrs",
+			},
+			"describecapacityreservationfleets": {
+				Name: "DescribeCapacityReservationFleets",
+			},
+			"describecapacityreservations": {
+				Name: "DescribeCapacityReservations",
+			},
+			"describecarriergateways": {
+				Name: "DescribeCarrierGateways",
+			},
+			"describeclassiclinkinstances": {
+				Name: "DescribeClassicLinkInstances",
+			},
+			"describeclientvpnauthorizationrules": {
+				Name: "DescribeClientVpnAuthorizationRules",
+			},
+			"describeclientvpnconnections": {
+				Name: "DescribeClientVpnConnections",
+			},
+			"describeclientvpnendpoints": {
+				Name: "DescribeClientVpnEndpoints",
+			},
+			"describeclientvpnroutes": {
+				Name: "DescribeClientVpnRoutes",
+			},
+			"describeclientvpntargetnetworks": {
+				Name: "DescribeClientVpnTargetNetworks",
+			},
+			"describecoippools": {
+				Name: "DescribeCoipPools",
+			},
+			"describeconversiontasks": {
+				Name: "DescribeConversionTasks",
+			},
+			"describecustomergateways": {
+				Name: "DescribeCustomerGateways",
+			},
+			"describedhcpoptions": {
+				Name: "DescribeDhcpOptions",
+			},
+			"describeegressonlyinternetgateways": {
+				Name: "DescribeEgressOnlyInternetGateways",
+			},
+			"describeelasticgpus": {
+				Name: "DescribeElasticGpus",
+			},
+			"describeexportimagetasks": {
+				Name: "DescribeExportImageTasks",
+			},
+			"describeexporttasks": {
+				Name: "DescribeExportTasks",
+			},
+			"describefastlaunchimages": {
+				Name: "DescribeFastLaunchImages",
+			},
+			"describefastsnapshotrestores": {
+				Name: "DescribeFastSnapshotRestores",
+			},
+			"describefleethistory": {
+				Name: "DescribeFleetHistory",
+			},
+			"describefleetinstances": {
+				Name: "DescribeFleetInstances",
+			},
+			"describefleets": {
+				Name: "DescribeFleets",
+			},
+			"describeflowlogs": {
+				Name: "DescribeFlowLogs",
+			},
+			"describefpgaimageattribute": {
+				Name: "DescribeFpgaImageAttribute",
+			},
+			"describefpgaimages": {
+				Name: "DescribeFpgaImages",
+			},
+			"describehostreservationofferings": {
+				Name: "DescribeHostReservationOfferings",
+			},
+			"describehostreservations": {
+				Name: "DescribeHostReservations",
+			},
+			"describehosts": {
+				Name: "DescribeHosts",
+			},
+			"describeiaminstanceprofileassociations": {
+				Name: "DescribeIamInstanceProfileAssociations",
+			},
+			"describeidformat": {
+				Name: "DescribeIdFormat",
+			},
+			"describeidentityidformat": {
+				Name: "DescribeIdentityIdFormat",
+			},
+			"describeimageattribute": {
+				Name: "DescribeImageAttribute",
+			},
+			"describeimages": {
+				Name: "DescribeImages",
+			},
+			"describeimportimagetasks": {
+				Name: "DescribeImportImageTasks",
+			},
+			"describeimportsnapshottasks": {
+				Name: "DescribeImportSnapshotTasks",
+			},
+			"describeinstanceattribute": {
+				Name: "DescribeInstanceAttribute",
+			},
+			"describeinstanceconnectendpoints": {
+				Name: "DescribeInstanceConnectEndpoints",
+			},
+			"describeinstancecreditspecifications": {
+				Name: "DescribeInstanceCreditSpecifications",
+			},
+			"describeinstanceeventnotificationattributes": {
+				Name: "DescribeInstanceEventNotificationAttributes",
+			},
+			"describeinstanceeventwindows": {
+				Name: "DescribeInstanceEventWindows",
+			},
+			"describeinstancestatus": {
+				Name: "DescribeInstanceStatus",
+			},
+			"describeinstancetypeofferings": {
+				Name: "DescribeInstanceTypeOfferings",
+			},
+			"describeinstancetypes": {
+				Name: "DescribeInstanceTypes",
+			},
+			"describeinstances": {
+				Name: "DescribeInstances",
+			},
+			"describeinternetgateways": {
+				Name: "DescribeInternetGateways",
+			},
+			"describeipampools": {
+				Name: "DescribeIpamPools",
+			},
+			"describeipamresourcediscoveries": {
+				Name: "DescribeIpamResourceDiscoveries",
+			},
+			"describeipamresourcediscoveryassociations": {
+				Name: "DescribeIpamResourceDiscoveryAssociations",
+			},
+			"describeipamscopes": {
+				Name: "DescribeIpamScopes",
+			},
+			"describeipams": {
+				Name: "DescribeIpams",
+			},
+			"describeipv6pools": {
+				Name: "DescribeIpv6Pools",
+			},
+			"describekeypairs": {
+				Name: "DescribeKeyPairs",
+			},
+			"describelaunchtemplateversions": {
+				Name: "DescribeLaunchTemplateVersions",
+			},
+			"describelaunchtemplates": {
+				Name: "DescribeLaunchTemplates",
+			},
+			"describelocalgatewayroutetablevirtualinterfacegroupassociations": {
+				Name: "DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations",
+			},
+			"describelocalgatewayroutetablevpcassociations": {
+				Name: "DescribeLocalGatewayRouteTableVpcAssociations",
+			},
+			"describelocalgatewayroutetables": {
+				Name: "DescribeLocalGatewayRouteTables",
+			},
+			"describelocalgatewayvirtualinterfacegroups": {
+				Name: "DescribeLocalGatewayVirtualInterfaceGroups",
+			},
+			"describelocalgatewayvirtualinterfaces": {
+				Name: "DescribeLocalGatewayVirtualInterfaces",
+			},
+			"describelocalgateways": {
+				Name: "DescribeLocalGateways",
+			},
+			"describemanagedprefixlists": {
+				Name: "DescribeManagedPrefixLists",
+			},
+			"describemovingaddresses": {
+				Name: "DescribeMovingAddresses",
+			},
+			"describenatgateways": {
+				Name: "DescribeNatGateways",
+			},
+			"describenetworkacls": {
+				Name: "DescribeNetworkAcls",
+			},
+			"describenetworkinsightsaccessscopeanalyses": {
+				Name: "DescribeNetworkInsightsAccessScopeAnalyses",
+			},
+			"describenetworkinsightsaccessscopes": {
+				Name: "DescribeNetworkInsightsAccessScopes",
+			},
+			"describenetworkinsightsanalyses": {
+				Name: "DescribeNetworkInsightsAnalyses",
+			},
+			"describenetworkinsightspaths": {
+				Name: "DescribeNetworkInsightsPaths",
+			},
+			"describenetworkinterfaceattribute": {
+				Name: "DescribeNetworkInterfaceAttribute",
+			},
+			"describenetworkinterfacepermissions": {
+				Name: "DescribeNetworkInterfacePermissions",
+			},
+			"describenetworkinterfaces": {
+				Name: "DescribeNetworkInterfaces",
+			},
+			"describeplacementgroups": {
+				Name: "DescribePlacementGroups",
+			},
+			"describeprefixlists": {
+				Name: "DescribePrefixLists",
+			},
+			"describeprincipalidformat": {
+				Name: "DescribePrincipalIdFormat",
+			},
+			"describepublicipv4pools": {
+				Name: "DescribePublicIpv4Pools",
+			},
+			"describeregions": {
+				Name: "DescribeRegions",
+			},
+			"describereplacerootvolumetasks": {
+				Name: "DescribeReplaceRootVolumeTasks",
+			},
+			"describereservedinstances": {
+				Name: "DescribeReservedInstances",
+			},
+			"describereservedinstanceslistings": {
+				Name: "DescribeReservedInstancesListings",
+			},
+			"describereservedinstancesmodifications": {
+				Name: "DescribeReservedInstancesModifications",
+			},
+			"describereservedinstancesofferings": {
+				Name: "DescribeReservedInstancesOfferings",
+			},
+			"describeroutetables": {
+				Name: "DescribeRouteTables",
+			},
+			"describescheduledinstanceavailability": {
+				Name: "DescribeScheduledInstanceAvailability",
+			},
+			"describescheduledinstances": {
+				Name: "DescribeScheduledInstances",
+			},
+			"describesecuritygroupreferences": {
+				Name: "DescribeSecurityGroupReferences",
+			},
+			"describesecuritygrouprules": {
+				Name: "DescribeSecurityGroupRules",
+			},
+			"describesecuritygroups": {
+				Name: "DescribeSecurityGroups",
+			},
+			"describesnapshotattribute": {
+				Name: "DescribeSnapshotAttribute",
+			},
+			"describesnapshottierstatus": {
+				Name: "DescribeSnapshotTierStatus",
+			},
+			"describesnapshots": {
+				Name: "DescribeSnapshots",
+			},
+			"describespotdatafeedsubscription": {
+				Name: "DescribeSpotDatafeedSubscription",
+			},
+			"describespotfleetinstances": {
+				Name: "DescribeSpotFleetInstances",
+			},
+			"describespotfleetrequesthistory": {
+				Name: "DescribeSpotFleetRequestHistory",
+			},
+			"describespotfleetrequests": {
+				Name: "DescribeSpotFleetRequests",
+			},
+			"describespotinstancerequests": {
+				Name: "DescribeSpotInstanceRequests",
+			},
+			"describespotpricehistory": {
+				Name: "DescribeSpotPriceHistory",
+			},
+			"describestalesecuritygroups": {
+				Name: "DescribeStaleSecurityGroups",
+			},
+			"describestoreimagetasks": {
+				Name: "DescribeStoreImageTasks",
+			},
+			"describesubnets": {
+				Name: "DescribeSubnets",
+			},
+			"describetags": {
+				Name: "DescribeTags",
+			},
+			"describetrafficmirrorfilters": {
+				Name: "DescribeTrafficMirrorFilters",
+			},
+			"describetrafficmirrorsessions": {
+				Name: "DescribeTrafficMirrorSessions",
+			},
+			"describetrafficmirrortargets": {
+				Name: "DescribeTrafficMirrorTargets",
+			},
+			"describetransitgatewayattachments": {
+				Name: "DescribeTransitGatewayAttachments",
+			},
+			"describetransitgatewayconnectpeers": {
+				Name: "DescribeTransitGatewayConnectPeers",
+			},
+			"describetransitgatewayconnects": {
+				Name: "DescribeTransitGatewayConnects",
+			},
+			"describetransitgatewaymulticastdomains": {
+				Name: "DescribeTransitGatewayMulticastDomains",
+			},
+			"describetransitgatewaypeeringattachments": {
+				Name: "DescribeTransitGatewayPeeringAttachments",
+			},
+			"describetransitgatewaypolicytables": {
+				Name: "DescribeTransitGatewayPolicyTables",
+			},
+			"describetransitgatewayroutetableannouncements": {
+				Name: "DescribeTransitGatewayRouteTableAnnouncements",
+			},
+			"describetransitgatewayroutetables": {
+				Name: "DescribeTransitGatewayRouteTables",
+			},
+			"describetransitgatewayvpcattachments": {
+				Name: "DescribeTransitGatewayVpcAttachments",
+			},
+			"describetransitgateways": {
+				Name: "DescribeTransitGateways",
+			},
+			"describetrunkinterfaceassociations": {
+				Name: "DescribeTrunkInterfaceAssociations",
+			},
+			"describeverifiedaccessendpoints": {
+				Name: "DescribeVerifiedAccessEndpoints",
+			},
+			"describeverifiedaccessgroups": {
+				Name: "DescribeVerifiedAccessGroups",
+			},
+			"describeverifiedaccessinstanceloggingconfigurations": {
+				Name: "DescribeVerifiedAccessInstanceLoggingConfigurations",
+			},
+			"describeverifiedaccessinstances": {
+				Name: "DescribeVerifiedAccessInstances",
+			},
+			"describeverifiedaccesstrustproviders": {
+				Name: "DescribeVerifiedAccessTrustProviders",
+			},
+			"describevolumeattribute": {
+				Name: "DescribeVolumeAttribute",
+			},
+			"describevolumestatus": {
+				Name: "DescribeVolumeStatus",
+			},
+			"describevolumes": {
+				Name: "DescribeVolumes",
+			},
+			"describevolumesmodifications": {
+				Name: "DescribeVolumesModifications",
+			},
+			"describevpcattribute": {
+				Name: "DescribeVpcAttribute",
+			},
+			"describevpcclassiclink": {
+				Name: "DescribeVpcClassicLink",
+			},
+			"describevpcclassiclinkdnssupport": {
+				Name: "DescribeVpcClassicLinkDnsSupport",
+			},
+			"describevpcendpointconnectionnotifications": {
+				Name: "DescribeVpcEndpointConnectionNotifications",
+			},
+			"describevpcendpointconnections": {
+				Name: "DescribeVpcEndpointConnections",
+			},
+			"describevpcendpointserviceconfigurations": {
+				Name: "DescribeVpcEndpointServiceConfigurations",
+			},
+			"describevpcendpointservicepermissions": {
+				Name: "DescribeVpcEndpointServicePermissions",
+			},
+			"describevpcendpointservices": {
+				Name: "DescribeVpcEndpointServices",
+			},
+			"describevpcendpoints": {
+				Name: "DescribeVpcEndpoints",
+			},
+			"describevpcpeeringconnections": {
+				Name: "DescribeVpcPeeringConnections",
+			},
+			"describevpcs": {
+				Name: "DescribeVpcs",
+			},
+			"describevpnconnections": {
+				Name: "DescribeVpnConnections",
+			},
+			"describevpngateways": {
+				Name: "DescribeVpnGateways",
+			},
+			"detachclassiclinkvpc": {
+				Name: "DetachClassicLinkVpc",
+			},
+			"detachinternetgateway": {
+				Name: "DetachInternetGateway",
+			},
+			"detachnetworkinterface": {
+				Name: "DetachNetworkInterface",
+			},
+			"detachverifiedaccesstrustprovider": {
+				Name: "DetachVerifiedAccessTrustProvider",
+			},
+			"detachvolume": {
+				Name: "DetachVolume",
+			},
+			"detachvpngateway": {
+				Name: "DetachVpnGateway",
+			},
+			"disableaddresstransfer": {
+				Name: "DisableAddressTransfer",
+			},
+			"disableawsnetworkperformancemetricsubscription": {
+				Name: "DisableAwsNetworkPerformanceMetricSubscription",
+			},
+			"disableebsencryptionbydefault": {
+				Name: "DisableEbsEncryptionByDefault",
+			},
+			"disablefastlaunch": {
+				Name: "DisableFastLaunch",
+			},
+			"disablefastsnapshotrestores": {
+				Name: "DisableFastSnapshotRestores",
+			},
+			"disableimagedeprecation": {
+				Name: "DisableImageDeprecation",
+			},
+			"disableipamorganizationadminaccount": {
+				Name: "DisableIpamOrganizationAdminAccount",
+			},
+			"disableserialconsoleaccess": {
+				Name: "DisableSerialConsoleAccess",
+			},
+			"disabletransitgatewayroutetablepropagation": {
+				Name: "DisableTransitGatewayRouteTablePropagation",
+			},
+			"disablevgwroutepropagation": {
+				Name: "DisableVgwRoutePropagation",
+			},
+			"disablevpcclassiclink": {
+				Name: "DisableVpcClassicLink",
+			},
+			"disablevpcclassiclinkdnssupport": {
+				Name: "DisableVpcClassicLinkDnsSupport",
+			},
+			"disassociateaddress": {
+				Name: "DisassociateAddress",
+			},
+			"disassociateclientvpntargetnetwork": {
+				Name: "DisassociateClientVpnTargetNetwork",
+			},
+			"disassociateenclavecertificateiamrole": {
+				Name: "DisassociateEnclaveCertificateIamRole",
+			},
+			"disassociateiaminstanceprofile": {
+				Name: "DisassociateIamInstanceProfile",
+			},
+			"disassociateinstanceeventwindow": {
+				Name: "DisassociateInstanceEventWindow",
+			},
+			"disassociateipamresourcediscovery": {
+				Name: "DisassociateIpamResourceDiscovery",
+			},
+			"disassociatenatgatewayaddress": {
+				Name: "DisassociateNatGatewayAddress",
+			},
+			"disassociateroutetable": {
+				Name: "DisassociateRouteTable",
+			},
+			"disassociatesubnetcidrblock": {
+				Name: "DisassociateSubnetCidrBlock",
+			},
+			"disassociatetransitgatewaymulticastdomain": {
+				Name: "DisassociateTransitGatewayMulticastDomain",
+			},
+			"disassociatetransitgatewaypolicytable": {
+				Name: "DisassociateTransitGatewayPolicyTable",
+			},
+			"disassociatetransitgatewayroutetable": {
+				Name: "DisassociateTransitGatewayRouteTable",
+			},
+			"disassociatetrunkinterface": {
+				Name: "DisassociateTrunkInterface",
+			},
+			"disassociatevpccidrblock": {
+				Name: "DisassociateVpcCidrBlock",
+			},
+			"enableaddresstransfer": {
+				Name: "EnableAddressTransfer",
+			},
+			"enableawsnetworkperformancemetricsubscription": {
+				Name: "EnableAwsNetworkPerformanceMetricSubscription",
+			},
+			"enableebsencryptionbydefault": {
+				Name: "EnableEbsEncryptionByDefault",
+			},
+			"enablefastlaunch": {
+				Name: "EnableFastLaunch",
+			},
+			"enablefastsnapshotrestores": {
+				Name: "EnableFastSnapshotRestores",
+			},
+			"enableimagedeprecation": {
+				Name: "EnableImageDeprecation",
+			},
+			"enableipamorganizationadminaccount": {
+				Name: "EnableIpamOrganizationAdminAccount",
+			},
+			"enablereachabilityanalyzerorganizationsharing": {
+				Name: "EnableReachabilityAnalyzerOrganizationSharing",
+			},
+			"enableserialconsoleaccess": {
+				Name: "EnableSerialConsoleAccess",
+			},
+			"enabletransitgatewayroutetablepropagation": {
+				Name: "EnableTransitGatewayRouteTablePropagation",
+			},
+			"enablevgwroutepropagation": {
+				Name: "EnableVgwRoutePropagation",
+			},
+			"enablevolumeio": {
+				Name: "EnableVolumeIO",
+			},
+			"enablevpcclassiclink": {
+				Name: "EnableVpcClassicLink",
+			},
+			"enablevpcclassiclinkdnssupport": {
+				Name: "EnableVpcClassicLinkDnsSupport",
+			},
+			"exportclientvpnclientcertificaterevocationlist": {
+				Name: "ExportClientVpnClientCertificateRevocationList",
+			},
+			"exportclientvpnclientconfiguration": {
+				Name: "ExportClientVpnClientConfiguration",
+			},
+			"exportimage": {
+				Name: "ExportImage",
+			},
+			"exporttransitgatewayroutes": {
+				Name: "ExportTransitGatewayRoutes",
+			},
+			"getassociatedenclavecertificateiamroles": {
+				Name: "GetAssociatedEnclaveCertificateIamRoles",
+			},
+			"getassociatedipv6poolcidrs": {
+				Name: "GetAssociatedIpv6PoolCidrs",
+			},
+			"getawsnetworkperformancedata": {
+				Name: "GetAwsNetworkPerformanceData",
+			},
+			"getcapacityreservationusage": {
+				Name: "GetCapacityReservationUsage",
+			},
+			"getcoippoolusage": {
+				Name: "GetCoipPoolUsage",
+			},
+			"getconsoleoutput": {
+				Name: "GetConsoleOutput",
+			},
+			"getconsolescreenshot": {
+				Name: "GetConsoleScreenshot",
+			},
+			"getdefaultcreditspecification": {
+				Name: "GetDefaultCreditSpecification",
+			},
+			"getebsdefaultkmskeyid": {
+				Name: "GetEbsDefaultKmsKeyId",
+			},
+			"getebsencryptionbydefault": {
+				Name: "GetEbsEncryptionByDefault",
+			},
+			"getflowlogsintegrationtemplate": {
+				Name: "GetFlowLogsIntegrationTemplate",
+			},
+			"getgroupsforcapacityreservation": {
+				Name: "GetGroupsForCapacityReservation",
+			},
+			"gethostreservationpurchasepreview": {
+				Name: "GetHostReservationPurchasePreview",
+			},
+			"getinstancetypesfrominstancerequirements": {
+				Name: "GetInstanceTypesFromInstanceRequirements",
+			},
+			"getinstanceuefidata": {
+				Name: "GetInstanceUefiData",
+			},
+			"getipamaddresshistory": {
+				Name: "GetIpamAddressHistory",
+			},
+			"getipamdiscoveredaccounts": {
+				Name: "GetIpamDiscoveredAccounts",
+			},
+			"getipamdiscoveredresourcecidrs": {
+				Name: "GetIpamDiscoveredResourceCidrs",
+			},
+			"getipampoolallocations": {
+				Name: "GetIpamPoolAllocations",
+			},
+			"getipampoolcidrs": {
+				Name: "GetIpamPoolCidrs",
+			},
+			"getipamresourcecidrs": {
+				Name: "GetIpamResourceCidrs",
+			},
+			"getlaunchtemplatedata": {
+				Name: "GetLaunchTemplateData",
+			},
+			"getmanagedprefixlistassociations": {
+				Name: "GetManagedPrefixListAssociations",
+			},
+			"getmanagedprefixlistentries": {
+				Name: "GetManagedPrefixListEntries",
+			},
+			"getnetworkinsightsaccessscopeanalysisfindings": {
+				Name: "GetNetworkInsightsAccessScopeAnalysisFindings",
+			},
+			"getnetworkinsightsaccessscopecontent": {
+				Name: "GetNetworkInsightsAccessScopeContent",
+			},
+			"getpassworddata": {
+				Name: "GetPasswordData",
+			},
+			"getreservedinstancesexchangequote": {
+				Name: "GetReservedInstancesExchangeQuote",
+			},
+			"getserialconsoleaccessstatus": {
+				Name: "GetSerialConsoleAccessStatus",
+			},
+			"getspotplacementscores": {
+				Name: "GetSpotPlacementScores",
+			},
+			"getsubnetcidrreservations": {
+				Name: "GetSubnetCidrReservations",
+			},
+			"gettransitgatewayattachmentpropagations": {
+				Name: "GetTransitGatewayAttachmentPropagations",
+			},
+			"gettransitgatewaymulticastdomainassociations": {
+				Name: "GetTransitGatewayMulticastDomainAssociations",
+			},
+			"gettransitgatewaypolicytableassociations": {
+				Name: "GetTransitGatewayPolicyTableAssociations",
+			},
+			"gettransitgatewaypolicytableentries": {
+				Name: "GetTransitGatewayPolicyTableEntries",
+			},
+			"gettransitgatewayprefixlistreferences": {
+				Name: "GetTransitGatewayPrefixListReferences",
+			},
+			"gettransitgatewayroutetableassociations": {
+				Name: "GetTransitGatewayRouteTableAssociations",
+			},
+			"gettransitgatewayroutetablepropagations": {
+				Name: "GetTransitGatewayRouteTablePropagations",
+			},
+			"getverifiedaccessendpointpolicy": {
+				Name: "GetVerifiedAccessEndpointPolicy",
+			},
+			"getverifiedaccessgrouppolicy": {
+				Name: "GetVerifiedAccessGroupPolicy",
+			},
+			"getvpnconnectiondevicesampleconfiguration": {
+				Name: "GetVpnConnectionDeviceSampleConfiguration",
+			},
+			"getvpnconnectiondevicetypes": {
+				Name: "GetVpnConnectionDeviceTypes",
+			},
+			"getvpntunnelreplacementstatus": {
+				Name: "GetVpnTunnelReplacementStatus",
+			},
+			"importclientvpnclientcertificaterevocationlist": {
+				Name: "ImportClientVpnClientCertificateRevocationList",
+			},
+			"importimage": {
+				Name: "ImportImage",
+			},
+			"importinstance": {
+				Name: "ImportInstance",
+			},
+			"importkeypair": {
+				Name: "ImportKeyPair",
+			},
+			"importsnapshot": {
+				Name: "ImportSnapshot",
+			},
+			"importvolume": {
+				Name: "ImportVolume",
+			},
+			"listimagesinrecyclebin": {
+				Name: "ListImagesInRecycleBin",
+			},
+			"listsnapshotsinrecyclebin": {
+				Name: "ListSnapshotsInRecycleBin",
+			},
+			"modifyaddressattribute": {
+				Name: "ModifyAddressAttribute",
+			},
+			"modifyavailabilityzonegroup": {
+				Name: "ModifyAvailabilityZoneGroup",
+			},
+			"modifycapacityreservation": {
+				Name: "ModifyCapacityReservation",
+			},
+			"modifycapacityreservationfleet": {
+				Name: "ModifyCapacityReservationFleet",
+			},
+			"modifyclientvpnendpoint": {
+				Name: "ModifyClientVpnEndpoint",
+			},
+			"modifydefaultcreditspecification": {
+				Name: "ModifyDefaultCreditSpecification",
+			},
+			"modifyebsdefaultkmskeyid": {
+				Name: "ModifyEbsDefaultKmsKeyId",
+			},
+			"modifyfleet": {
+				Name: "ModifyFleet",
+			},
+			"modifyfpgaimageattribute": {
+				Name: "ModifyFpgaImageAttribute",
+			},
+			"modifyhosts": {
+				Name: "ModifyHosts",
+			},
+			"modifyidformat": {
+				Name: "ModifyIdFormat",
+			},
+			"modifyidentityidformat": {
+				Name: "ModifyIdentityIdFormat",
+			},
+			"modifyimageattribute": {
+				Name: "ModifyImageAttribute",
+			},
+			"modifyinstanceattribute": {
+				Name: "ModifyInstanceAttribute",
+			},
+			"modifyinstancecapacityreservationattributes": {
+				Name: "ModifyInstanceCapacityReservationAttributes",
+			},
+			"modifyinstancecreditspecification": {
+				Name: "ModifyInstanceCreditSpecification",
+			},
+			"modifyinstanceeventstarttime": {
+				Name: "ModifyInstanceEventStartTime",
+			},
+			"modifyinstanceeventwindow": {
+				Name: "ModifyInstanceEventWindow",
+			},
+			"modifyinstancemaintenanceoptions": {
+				Name: "ModifyInstanceMaintenanceOptions",
+			},
+			"modifyinstancemetadataoptions": {
+				Name: "ModifyInstanceMetadataOptions",
+			},
+			"modifyinstanceplacement": {
+				Name: "ModifyInstancePlacement",
+			},
+			"modifyipam": {
+				Name: "ModifyIpam",
+			},
+			"modifyipampool": {
+				Name: "ModifyIpamPool",
+			},
+			"modifyipamresourcecidr": {
+				Name: "ModifyIpamResourceCidr",
+			},
+			"modifyipamresourcediscovery": {
+				Name: "ModifyIpamResourceDiscovery",
+			},
+			"modifyipamscope": {
+				Name: "ModifyIpamScope",
+			},
+			"modifylaunchtemplate": {
+				Name: "ModifyLaunchTemplate",
+			},
+			"modifylocalgatewayroute": {
+				Name: "ModifyLocalGatewayRoute",
+			},
+			"modifymanagedprefixlist": {
+				Name: "ModifyManagedPrefixList",
+			},
+			"modifynetworkinterfaceattribute": {
+				Name: "ModifyNetworkInterfaceAttribute",
+			},
+			"modifyprivatednsnameoptions": {
+				Name: "ModifyPrivateDnsNameOptions",
+			},
+			"modifyreservedinstances": {
+				Name: "ModifyReservedInstances",
+			},
+			"modifysecuritygrouprules": {
+				Name: "ModifySecurityGroupRules",
+			},
+			"modifysnapshotattribute": {
+				Name: "ModifySnapshotAttribute",
+			},
+			"modifysnapshottier": {
+				Name: "ModifySnapshotTier",
+			},
+			"modifyspotfleetrequest": {
+				Name: "ModifySpotFleetRequest",
+			},
+			"modifysubnetattribute": {
+				Name: "ModifySubnetAttribute",
+			},
+			"modifytrafficmirrorfilternetworkservices": {
+				Name: "ModifyTrafficMirrorFilterNetworkServices",
+			},
+			"modifytrafficmirrorfilterrule": {
+				Name: "ModifyTrafficMirrorFilterRule",
+			},
+			"modifytrafficmirrorsession": {
+				Name: "ModifyTrafficMirrorSession",
+			},
+			"modifytransitgateway": {
+				Name: "ModifyTransitGateway",
+			},
+			"modifytransitgatewayprefixlistreference": {
+				Name: "ModifyTransitGatewayPrefixListReference",
+			},
+			"modifytransitgatewayvpcattachment": {
+				Name: "ModifyTransitGatewayVpcAttachment",
+			},
+			"modifyverifiedaccessendpoint": {
+				Name: "ModifyVerifiedAccessEndpoint",
+			},
+			"modifyverifiedaccessendpointpolicy": {
+				Name: "ModifyVerifiedAccessEndpointPolicy",
+			},
+			"modifyverifiedaccessgroup": {
+				Name: "ModifyVerifiedAccessGroup",
+			},
+			"modifyverifiedaccessgrouppolicy": {
+				Name: "ModifyVerifiedAccessGroupPolicy",
+			},
+			"modifyverifiedaccessinstance": {
+				Name: "ModifyVerifiedAccessInstance",
+			},
+			"modifyverifiedaccessinstanceloggingconfiguration": {
+				Name: "ModifyVerifiedAccessInstanceLoggingConfiguration",
+			},
+			"modifyverifiedaccesstrustprovider": {
+				Name: "ModifyVerifiedAccessTrustProvider",
+			},
+			"modifyvolume": {
+				Name: "ModifyVolume",
+			},
+			"modifyvolumeattribute": {
+				Name: "ModifyVolumeAttribute",
+			},
+			"modifyvpcattribute": {
+				Name: "ModifyVpcAttribute",
+			},
+			"modifyvpcendpoint": {
+				Name: "ModifyVpcEndpoint",
+			},
+			"modifyvpcendpointconnectionnotification": {
+				Name: "ModifyVpcEndpointConnectionNotification",
+			},
+			"modifyvpcendpointserviceconfiguration": {
+				Name: "ModifyVpcEndpointServiceConfiguration",
+			},
+			"modifyvpcendpointservicepayerresponsibility": {
+				Name: "ModifyVpcEndpointServicePayerResponsibility",
+			},
+			"modifyvpcendpointservicepermissions": {
+				Name: "ModifyVpcEndpointServicePermissions",
+			},
+			"modifyvpcpeeringconnectionoptions": {
+				Name: "ModifyVpcPeeringConnectionOptions",
+			},
+			"modifyvpctenancy": {
+				Name: "ModifyVpcTenancy",
+			},
+			"modifyvpnconnection": {
+				Name: "ModifyVpnConnection",
+			},
+			"modifyvpnconnectionoptions": {
+				Name: "ModifyVpnConnectionOptions",
+			},
+			"modifyvpntunnelcertificate": {
+				Name: "ModifyVpnTunnelCertificate",
+			},
+			"modifyvpntunneloptions": {
+				Name: "ModifyVpnTunnelOptions",
+			},
+			"monitorinstances": {
+				Name: "MonitorInstances",
+			},
+			"moveaddresstovpc": {
+				Name: "MoveAddressToVpc",
+			},
+			"movebyoipcidrtoipam": {
+				Name: "MoveByoipCidrToIpam",
+			},
+			"provisionbyoipcidr": {
+				Name: "ProvisionByoipCidr",
+			},
+			"provisionipampoolcidr": {
+				Name: "ProvisionIpamPoolCidr",
+			},
+			"provisionpublicipv4poolcidr": {
+				Name: "ProvisionPublicIpv4PoolCidr",
+			},
+			"purchasehostreservation": {
+				Name: "PurchaseHostReservation",
+			},
+			"purchasereservedinstancesoffering": {
+				Name: "PurchaseReservedInstancesOffering",
+			},
+			"purchasescheduledinstances": {
+				Name: "PurchaseScheduledInstances",
+			},
+			"rebootinstances": {
+				Name: "RebootInstances",
+			},
+			"registerimage": {
+				Name: "RegisterImage",
+			},
+			"registerinstanceeventnotificationattributes": {
+				Name: "RegisterInstanceEventNotificationAttributes",
+			},
+			"registertransitgatewaymulticastgroupmembers": {
+				Name: "RegisterTransitGatewayMulticastGroupMembers",
+			},
+			"registertransitgatewaymulticastgroupsources": {
+				Name: "RegisterTransitGatewayMulticastGroupSources",
+			},
+			"rejecttransitgatewaymulticastdomainassociations": {
+				Name: "RejectTransitGatewayMulticastDomainAssociations",
+			},
+			"rejecttransitgatewaypeeringattachment": {
+				Name: "RejectTransitGatewayPeeringAttachment",
+			},
+			"rejecttransitgatewayvpcattachment": {
+				Name: "RejectTransitGatewayVpcAttachment",
+			},
+			"rejectvpcendpointconnections": {
+				Name: "RejectVpcEndpointConnections",
+			},
+			"rejectvpcpeeringconnection": {
+				Name: "RejectVpcPeeringConnection",
+			},
+			"releaseaddress": {
+				Name: "ReleaseAddress",
+			},
+			"releasehosts": {
+				Name: "ReleaseHosts",
+			},
+			"releaseipampoolallocation": {
+				Name: "ReleaseIpamPoolAllocation",
+			},
+			"replaceiaminstanceprofileassociation": {
+				Name: "ReplaceIamInstanceProfileAssociation",
+			},
+			"replacenetworkaclassociation": {
+				Name: "ReplaceNetworkAclAssociation",
+			},
+			"replacenetworkaclentry": {
+				Name: "ReplaceNetworkAclEntry",
+			},
+			"replaceroute": {
+				Name: "ReplaceRoute",
+			},
+			"replaceroutetableassociation": {
+				Name: "ReplaceRouteTableAssociation",
+			},
+			"replacetransitgatewayroute": {
+				Name: "ReplaceTransitGatewayRoute",
+			},
+			"replacevpntunnel": {
+				Name: "ReplaceVpnTunnel",
+			},
+			"reportinstancestatus": {
+				Name: "ReportInstanceStatus",
+			},
+			"requestspotfleet": {
+				Name: "RequestSpotFleet",
+			},
+			"requestspotinstances": {
+				Name: "RequestSpotInstances",
+			},
+			"resetaddressattribute": {
+				Name: "ResetAddressAttribute",
+			},
+			"resetebsdefaultkmskeyid": {
+				Name: "ResetEbsDefaultKmsKeyId",
+			},
+			"resetfpgaimageattribute": {
+				Name: "ResetFpgaImageAttribute",
+			},
+			"resetimageattribute": {
+				Name: "ResetImageAttribute",
+			},
+			"resetinstanceattribute": {
+				Name: "ResetInstanceAttribute",
+			},
+			"resetnetworkinterfaceattribute": {
+				Name: "ResetNetworkInterfaceAttribute",
+			},
+			"resetsnapshotattribute": {
+				Name: "ResetSnapshotAttribute",
+			},
+			"restoreaddresstoclassic": {
+				Name: "RestoreAddressToClassic",
+			},
+			"restoreimagefromrecyclebin": {
+				Name: "RestoreImageFromRecycleBin",
+			},
+			"restoremanagedprefixlistversion": {
+				Name: "RestoreManagedPrefixListVersion",
+			},
+			"restoresnapshotfromrecyclebin": {
+				Name: "RestoreSnapshotFromRecycleBin",
+			},
+			"restoresnapshottier": {
+				Name: "RestoreSnapshotTier",
+			},
+			"revokeclientvpningress": {
+				Name: "RevokeClientVpnIngress",
+			},
+			"revokesecuritygroupegress": {
+				Name: "RevokeSecurityGroupEgress",
+			},
+			"revokesecuritygroupingress": {
+				Name: "RevokeSecurityGroupIngress",
+			},
+			"runinstances": {
+				Name:          "RunInstances",
+				ResourceTypes: []string{"instance", "image", "network-interface", "security-group", "subnet", "volume", "key-pair", "launch-template"},
+				ConditionKeys: []string{"ec2:AvailabilityZone", "ec2:InstanceType", "ec2:MetadataHttpTokens", "ec2:Region", "ec2:Subnet", "ec2:Vpc", "aws:RequestTag/${TagKey}", "aws:TagKeys"},
+			},
+			"runscheduledinstances": {
+				Name: "RunScheduledInstances",
+			},
+			"searchlocalgatewayroutes": {
+				Name: "SearchLocalGatewayRoutes",
+			},
+			"searchtransitgatewaymulticastgroups": {
+				Name: "SearchTransitGatewayMulticastGroups",
+			},
+			"searchtransitgatewayroutes": {
+				Name: "SearchTransitGatewayRoutes",
+			},
+			"senddiagnosticinterrupt": {
+				Name: "SendDiagnosticInterrupt",
+			},
+			"startinstances": {
+				Name: "StartInstances",
+			},
+			"startnetworkinsightsaccessscopeanalysis": {
+				Name: "StartNetworkInsightsAccessScopeAnalysis",
+			},
+			"startnetworkinsightsanalysis": {
+				Name: "StartNetworkInsightsAnalysis",
+			},
+			"startvpcendpointserviceprivatednsverification": {
+				Name: "StartVpcEndpointServicePrivateDnsVerification",
+			},
+			"stopinstances": {
+				Name: "StopInstances",
+			},
+			"terminateclientvpnconnections": {
+				Name: "TerminateClientVpnConnections",
+			},
+			"terminateinstances": {
+				Name:          "TerminateInstances",
+				ResourceTypes: []string{"instance"},
+				ConditionKeys: []string{"ec2:ResourceTag/${TagKey}", "ec2:Region"},
+			},
+			"unassignipv6addresses": {
+				Name: "UnassignIpv6Addresses",
+			},
+			"unassignprivateipaddresses": {
+				Name: "UnassignPrivateIpAddresses",
+			},
+			"unassignprivatenatgatewayaddress": {
+				Name: "UnassignPrivateNatGatewayAddress",
+			},
+			"unmonitorinstances": {
+				Name: "UnmonitorInstances",
+			},
+			"updatesecuritygroupruledescriptionsegress": {
+				Name: "UpdateSecurityGroupRuleDescriptionsEgress",
+			},
+			"updatesecuritygroupruledescriptionsingress": {
+				Name: "UpdateSecurityGroupRuleDescriptionsIngress",
+			},
+			"withdrawbyoipcidr": {
+				Name: "WithdrawByoipCidr",
+			},
+		},
+	},
+	"ecr": {
+		Name:   "Amazon EC2 Container Registry",
+		Prefix: "ecr",
+		Actions: map[string]*Action{
+			"batchchecklayeravailability": {
+				Name: "BatchCheckLayerAvailability",
+			},
+			"batchdeleteimage": {
+				Name: "BatchDeleteImage",
+			},
+			"batchgetimage": {
+				Name:          "BatchGetImage",
+				ResourceTypes: []string{"repository"},
+			},
+			"batchgetrepositoryscanningconfiguration": {
+				Name: "BatchGetRepositoryScanningConfiguration",
+			},
+			"batchimportupstreamimage": {
+				Name: "BatchImportUpstreamImage",
+			},
+			"completelayerupload": {
+				Name: "CompleteLayerUpload",
+			},
+			"createpullthroughcacherule": {
+				Name: "CreatePullThroughCacheRule",
+			},
+			"createrepository": {
+				Name: "CreateRepository",
+			},
+			"deletelifecyclepolicy": {
+				Name: "DeleteLifecyclePolicy",
+			},
+			"deletepullthroughcacherule": {
+				Name: "DeletePullThroughCacheRule",
+			},
+			"deleteregistrypolicy": {
+				Name: "DeleteRegistryPolicy",
+			},
+			"deleterepository": {
+				Name: "DeleteRepository",
+			},
+			"deleterepositorypolicy": {
+				Name: "DeleteRepositoryPolicy",
+			},
+			"describeimagereplicationstatus": {
+				Name: "DescribeImageReplicationStatus",
+			},
+			"describeimagescanfindings": {
+				Name: "DescribeImageScanFindings",
+			},
+			"describeimages": {
+				Name: "DescribeImages",
+			},
+			"describepullthroughcacherules": {
+				Name: "DescribePullThroughCacheRules",
+			},
+			"describeregistry": {
+				Name: "DescribeRegistry",
+			},
+			"describerepositories": {
+				Name: "DescribeRepositories",
+			},
+			"getauthorizationtoken": {
+				Name: "GetAuthorizationToken",
+			},
+			"getdownloadurlforlayer": {
+				Name:          "GetDownloadUrlForLayer",
+				ResourceTypes: []string{"repository"},
+			},
+			"getlifecyclepolicy": {
+				Name: "GetLifecyclePolicy",
+			},
+			"getlifecyclepolicypreview": {
+				Name: "GetLifecyclePolicyPreview",
+			},
+			"getregistrypolicy": {
+				Name: "GetRegistryPolicy",
+			},
+			"getregistryscanningconfiguration": {
+				Name: "GetRegistryScanningConfiguration",
+			},
+			"getrepositorypolicy": {
+				Name: "GetRepositoryPolicy",
+			},
+			"initiatelayerupload": {
+				Name: "InitiateLayerUpload",
+			},
+			"listimages": {
+				Name: "ListImages",
+			},
+			"listtagsforresource": {
+				Name: "ListTagsForResource",
+			},
+			"putimage": {
+				Name:          "PutImage",
+				ResourceTypes: []string{"repository"},
+			},
+			"putimagescanningconfiguration": {
+				Name: "PutImageScanningConfiguration",
+			},
+			"putimagetagmutability": {
+				Name: "PutImageTagMutability",
+			},
+			"putlifecyclepolicy": {
+				Name: "PutLifecyclePolicy",
+			},
+			"putregistrypolicy": {
+				Name: "PutRegistryPolicy",
+			},
+			"putregistryscanningconfiguration": {
+				Name: "PutRegistryScanningConfiguration",
+			},
+			"putreplicationconfiguration": {
+				Name: "PutReplicationConfiguration",
+			},
+			"replicateimage": {
+				Name: "ReplicateImage",
+			},
+			"setrepositorypolicy": {
+				Name: "SetRepositoryPolicy",
+			},
+			"startimagescan": {
+				Name: "StartImageScan",
+			},
+			"startlifecyclepolicypreview": {
+				Name: "StartLifecyclePolicyPreview",
+			},
+			"tagresource": {
+				Name: "TagResource",
+			},
+			"untagresource": {
+				Name: "UntagResource",
+			},
+			"uploadlayerpart": {
+				Name: "UploadLayerPart",
+			},
+		},
+	},
+	"ecs": {
+		Name:          "Amazon EC2 Container Service",
+		Prefix:        "ecs",
+		ConditionKeys: []string{"ecs:cluster", "ecs:container-instances", "ecs:container-name", "ecs:service", "ecs:task-definition"},
+		Actions: map[string]*Action{
+			"createcapacityprovider": {
+				Name: "CreateCapacityProvider",
+			},
+			"createcluster": {
+				Name: "CreateCluster",
+			},
+			"createservice": {
+				Name: "CreateService",
+			},
+			"createtaskset": {
+				Name: "CreateTaskSet",
+			},
+			"deleteaccountsetting": {
+				Name: "DeleteAccountSetting",
+			},
+			"deleteattributes": {
+				Name: "DeleteAttributes",
+			},
+			"deletecapacityprovider": {
+				Name: "DeleteCapacityProvider",
+			},
+			"deletecluster": {
+				Name: "DeleteCluster",
+			},
+			"deleteservice": {
+				Name: "DeleteService",
+			},
+			"deletetaskdefinitions": {
+				Name: "DeleteTaskDefinitions",
+			},
+			"deletetaskset": {
+				Name: "DeleteTaskSet",
+			},
+			"deregistercontainerinstance": {
+				Name: "DeregisterContainerInstance",
+			},
+			"deregistertaskdefinition": {
+				Name: "DeregisterTaskDefinition",
+			},
+			"describecapacityproviders": {
+				Name: "DescribeCapacityProviders",
+			},
+			"describeclusters": {
+				Name: "DescribeClusters",
+			},
+			"describecontainerinstances": {
+				Name: "DescribeContainerInstances",
+			},
+			"describeservices": {
+				Name: "DescribeServices",
+			},
+			"describetaskdefinition": {
+				Name: "DescribeTaskDefinition",
+			},
+			"describetasksets": {
+				Name: "DescribeTaskSets",
+			},
+			"describetasks": {
+				Name: "DescribeTasks",
+			},
+			"discoverpollendpoint": {
+				Name: "DiscoverPollEndpoint",
+			},
+			"executecommand": {
+				Name:          "ExecuteCommand",
+				ResourceTypes: []string{"cluster", "task"},
+				ConditionKeys: []string{"ecs:cluster", "ecs:container-name", "ecs:task"},
+			},
+			"gettaskprotection": {
+				Name: "GetTaskProtection",
+			},
+			"listaccountsettings": {
+				Name: "ListAccountSettings",
+			},
+			"listattributes": {
+				Name: "ListAttributes",
+			},
+			"listclusters": {
+				Name: "ListClusters",
+			},
+			"listcontainerinstances": {
+				Name: "ListContainerInstances",
+			},
+			"listservices": {
+				Name: "ListServices",
+			},
+			"listservicesbynamespace": {
+				Name: "ListServicesByNamespace",
+			},
+			"listtagsforresource": {
+				Name: "ListTagsForResource",
+			},
+			"listtaskdefinitionfamilies": {
+				Name: "ListTaskDefinitionFamilies",
+			},
+			"listtaskdefinitions": {
+				Name: "ListTaskDefinitions",
+			},
+			"listtasks": {
+				Name: "ListTasks",
+			},
+			"poll": {
+				Name: "Poll",
+			},
+			"putaccountsetting": {
+				Name: "PutAccountSetting",
+			},
+			"putaccountsettingdefault": {
+				Name: "PutAccountSettingDefault",
+			},
+			"putattributes": {
+				Name: "PutAttributes",
+			},
+			"putclustercapacityproviders": {
+				Name: "PutClusterCapacityProviders",
+			},
+			"registercontainerinstance": {
+				Name: "RegisterContainerInstance",
+			},
+			"registertaskdefinition": {
+				Name: "RegisterTaskDefinition",
+			},
+			"runtask": {
+				Name:          "RunTask",
+				ResourceTypes: []string{"task-definition"},
+				ConditionKeys: []string{"ecs:cluster", "ecs:capacity-provider", "aws:RequestTag/${TagKey}", "aws:TagKeys"},
+			},
+			"starttask": {
+				Name: "StartTask",
+			},
+			"starttelemetrysession": {
+				Name: "StartTelemetrySession",
+			},
+			"stoptask": {
+				Name: "StopTask",
+			},
+			"submitattachmentstatechanges": {
+				Name: "SubmitAttachmentStateChanges",
+			},
+			"submitcontainerstatechange": {
+				Name: "SubmitContainerStateChange",
+			},
+			"submittaskstatechange": {
+				Name: "SubmitTaskStateChange",
+			},
+			"tagresource": {
+				Name: "TagResource",
+			},
+			"untagresource": {
+				Name: "UntagResource",
+			},
+			"updatecapacityprovider": {
+				Name: "UpdateCapacityProvider",
+			},
+			"updatecluster": {
+				Name: "UpdateCluster",
+			},
+			"updateclustersettings": {
+				Name: "UpdateClusterSettings",
+			},
+			"updatecontaineragent": {
+				Name: "UpdateContainerAgent",
+			},
+			"updatecontainerinstancesstate": {
+				Name: "UpdateContainerInstancesState",
+			},
+			"updateservice": {
+				Name: "UpdateService",
+			},
+			"updateserviceprimarytaskset": {
+				Name: "UpdateServicePrimaryTaskSet",
+			},
+			"updatetaskprotection": {
+				Name: "UpdateTaskProtection",
+			},
+			"updatetaskset": {
+				Name: "UpdateTaskSet",
+			},
+		},
+	},
+	"iam": {
+		Name:          "AWS Identity and Access Management",
+		Prefix:        "iam",
+		ConditionKeys: []string{"iam:AWSServiceName", "iam:AssociatedResourceArn", "iam:OrganizationsPolicyId", "iam:PassedToService", "iam:PermissionsBoundary", "iam:PolicyARN", "iam:ResourceTag/${TagKey}"},
+		Actions: map[string]*Action{
+			"addclientidtoopenidconnectprovider": {
+				Name: "AddClientIDToOpenIDConnectProvider",
+			},
+			"addroletoinstanceprofile": {
+				Name: "AddRoleToInstanceProfile",
+			},
+			"addusertogroup": {
+				Name: "AddUserToGroup",
+			},
+			"attachgrouppolicy": {
+				Name: "AttachGroupPolicy",
+			},
+			"attachrolepolicy": {
+				Name:          "AttachRolePolicy",
+				ResourceTypes: []string{"role"},
+				ConditionKeys: []string{"iam:PermissionsBoundary", "iam:PolicyARN"},
+			},
+			"attachuserpolicy": {
+				Name: "AttachUserPolicy",
+			},
+			"changepassword": {
+				Name: "ChangePassword",
+			},
+			"createaccesskey": {
+				Name: "CreateAccessKey",
+			},
+			"createaccountalias": {
+				Name: "CreateAccountAlias",
+			},
+			"creategroup": {
+				Name: "CreateGroup",
+			},
+			"createinstanceprofile": {
+				Name: "CreateInstanceProfile",
+			},
+			"createloginprofile": {
+				Name: "CreateLoginProfile",
+			},
+			"createopenidconnectprovider": {
+				Name: "CreateOpenIDConnectProvider",
+			},
+			"createpolicy": {
+				Name: "CreatePolicy",
+			},
+			"createpolicyversion": {
+				Name: "CreatePolicyVersion",
+			},
+			"createrole": {
+				Name:          "CreateRole",
+				ResourceTypes: []string{"role"},
+				ConditionKeys: []string{"iam:PermissionsBoundary", "aws:RequestTag/${TagKey}", "aws:TagKeys"},
+			},
+			"createsamlprovider": {
+				Name: "CreateSAMLProvider",
+			},
+			"createservicelinkedrole": {
+				Name:          "CreateServiceLinkedRole",
+				ResourceTypes: []string{"role"},
+				ConditionKeys: []string{"iam:AWSServiceName"},
+			},
+			"createservicespecificcredential": {
+				Name: "CreateServiceSpecificCredential",
+			},
+			"createuser": {
+				Name: "CreateUser",
+			},
+			"createvirtualmfadevice": {
+				Name: "CreateVirtualMFADevice",
+			},
+			"deactivatemfadevice": {
+				Name: "DeactivateMFADevice",
+			},
+			"deleteaccesskey": {
+				Name: "DeleteAccessKey",
+			},
+			"deleteaccountalias": {
+				Name: "DeleteAccountAlias",
+			},
+			"deleteaccountpasswordpolicy": {
+				Name: "DeleteAccountPasswordPolicy",
+			},
+			"deletegroup": {
+				Name: "DeleteGroup",
+			},
+			"deletegrouppolicy": {
+				Name: "DeleteGroupPolicy",
+			},
+			"deleteinstanceprofile": {
+				Name: "DeleteInstanceProfile",
+			},
+			"deleteloginprofile": {
+				Name: "DeleteLoginProfile",
+			},
+			"deleteopenidconnectprovider": {
+				Name: "DeleteOpenIDConnectProvider",
+			},
+			"deletepolicy": {
+				Name: "DeletePolicy",
+			},
+			"deletepolicyversion": {
+				Name: "DeletePolicyVersion",
+			},
+			"deleterole": {
+				Name: "DeleteRole",
+			},
+			"deleterolepermissionsboundary": {
+				Name: "DeleteRolePermissionsBoundary",
+			},
+			"deleterolepolicy": {
+				Name: "DeleteRolePolicy",
+			},
+			"deletesamlprovider": {
+				Name: "DeleteSAMLProvider",
+			},
+			"deletesshpublickey": {
+				Name: "DeleteSSHPublicKey",
+			},
+			"deleteservercertificate": {
+				Name: "DeleteServerCertificate",
+			},
+			"deleteservicelinkedrole": {
+				Name: "DeleteServiceLinkedRole",
+			},
+			"deleteservicespecificcredential": {
+				Name: "DeleteServiceSpecificCredential",
+			},
+			"deletesigningcertificate": {
+				Name: "DeleteSigningCertificate",
+			},
+			"deleteuser": {
+				Name: "DeleteUser",
+			},
+			"deleteuserpermissionsboundary": {
+				Name: "DeleteUserPermissionsBoundary",
+			},
+			"deleteuserpolicy": {
+				Name: "DeleteUserPolicy",
+			},
+			"deletevirtualmfadevice": {
+				Name: "DeleteVirtualMFADevice",
+			},
+			"detachgrouppolicy": {
+				Name: "DetachGroupPolicy",
+			},
+			"detachrolepolicy": {
+				Name: "DetachRolePolicy",
+			},
+			"detachuserpolicy": {
+				Name: "DetachUserPolicy",
+			},
+			"enablemfadevice": {
+				Name: "EnableMFADevice",
+			},
+			"generatecredentialreport": {
+				Name: "GenerateCredentialReport",
+			},
+			"generateorganizationsaccessreport": {
+				Name: "GenerateOrganizationsAccessReport",
+			},
+			"generateservicelastaccesseddetails": {
+				Name: "GenerateServiceLastAccessedDetails",
+			},
+			"getaccesskeylastused": {
+				Name: "GetAccessKeyLastUsed",
+			},
+			"getaccountauthorizationdetails": {
+				Name: "GetAccountAuthorizationDetails",
+			},
+			"getaccountemailaddress": {
+				Name: "GetAccountEmailAddress",
+			},
+			"getaccountname": {
+				Name: "GetAccountName",
+			},
+			"getaccountpasswordpolicy": {
+				Name: "GetAccountPasswordPolicy",
+			},
+			"getaccountsummary": {
+				Name: "GetAccountSummary",
+			},
+			"getcontextkeysforcustompolicy": {
+				Name: "GetContextKeysForCustomPolicy",
+			},
+			"getcontextkeysforprincipalpolicy": {
+				Name: "GetContextKeysForPrincipalPolicy",
+			},
+			"getcredentialreport": {
+				Name: "GetCredentialReport",
+			},
+			"getgroup": {
+				Name: "GetGroup",
+			},
+			"getgrouppolicy": {
+				Name: "GetGroupPolicy",
+			},
+			"getinstanceprofile": {
+				Name: "GetInstanceProfile",
+			},
+			"getloginprofile": {
+				Name: "GetLoginProfile",
+			},
+			"getmfadevice": {
+				Name: "GetMFADevice",
+			},
+			"getopenidconnectprovider": {
+				Name: "GetOpenIDConnectProvider",
+			},
+			"getorganizationsaccessreport": {
+				Name: "GetOrganizationsAccessReport",
+			},
+			"getpolicy": {
+				Name: "GetPolicy",
+			},
+			"getpolicyversion": {
+				Name: "GetPolicyVersion",
+			},
+			"getrole": {
+				Name: "GetRole",
+			},
+			"getrolepolicy": {
+				Name: "GetRolePolicy",
+			},
+			"getsamlprovider": {
+				Name: "GetSAMLProvider",
+			},
+			"getsshpublickey": {
+				Name: "GetSSHPublicKey",
+			},
+			"getservercertificate": {
+				Name: "GetServerCertificate",
+			},
+			"getservicelastaccesseddetails": {
+				Name: "GetServiceLastAccessedDetails",
+			},
+			"getservicelastaccesseddetailswithentities": {
+				Name: "GetServiceLastAccessedDetailsWithEntities",
+			},
+			"getservicelinkedroledeletionstatus": {
+				Name: "GetServiceLinkedRoleDeletionStatus",
+			},
+			"getuser": {
+				Name: "GetUser",
+			},
+			"getuserpolicy": {
+				Name: "GetUserPolicy",
+			},
+			"listaccesskeys": {
+				Name: "ListAccessKeys",
+			},
+			"listaccountaliases": {
+				Name: "ListAccountAliases",
+			},
+			"listattachedgrouppolicies": {
+				Name: "ListAttachedGroupPolicies",
+			},
+			"listattachedrolepolicies": {
+				Name: "ListAttachedRolePolicies",
+			},
+			"listattacheduserpolicies": {
+				Name: "ListAttachedUserPolicies",
+			},
+			"listentitiesforpolicy": {
+				Name: "ListEntitiesForPolicy",
+			},
+			"listgrouppolicies": {
+				Name: "ListGroupPolicies",
+			},
+			"listgroups": {
+				Name: "ListGroups",
+			},
+			"listgroupsforuser": {
+				Name: "ListGroupsForUser",
+			},
+			"listinstanceprofiletags": {
+				Name: "ListInstanceProfileTags",
+			},
+			"listinstanceprofiles": {
+				Name: "ListInstanceProfiles",
+			},
+			"listinstanceprofilesforrole": {
+				Name: "ListInstanceProfilesForRole",
+			},
+			"listmfadevicetags": {
+				Name: "ListMFADeviceTags",
+			},
+			"listmfadevices": {
+				Name: "ListMFADevices",
+			},
+			"listopenidconnectprovidertags": {
+				Name: "ListOpenIDConnectProviderTags",
+			},
+			"listopenidconnectproviders": {
+				Name: "ListOpenIDConnectProviders",
+			},
+			"listpolicies": {
+				Name: "ListPolicies",
+			},
+			"listpoliciesgrantingserviceaccess": {
+				Name: "ListPoliciesGrantingServiceAccess",
+			},
+			"listpolicytags": {
+				Name: "ListPolicyTags",
+			},
+			"listpolicyversions": {
+				Name: "ListPolicyVersions",
+			},
+			"listrolepolicies": {
+				Name: "ListRolePolicies",
+			},
+			"listroletags": {
+				Name: "ListRoleTags",
+			},
+			"listroles": {
+				Name: "ListRoles",
+			},
+			"listsamlprovidertags": {
+				Name: "ListSAMLProviderTags",
+			},
+			"listsamlproviders": {
+				Name: "ListSAMLProviders",
+			},
+			"listsshpublickeys": {
+				Name: "ListSSHPublicKeys",
+			},
+			"listservercertificatetags": {
+				Name: "ListServerCertificateTags",
+			},
+			"listservercertificates": {
+				Name: "ListServerCertificates",
+			},
+			"listservicespecificcredentials": {
+				Name: "ListServiceSpecificCredentials",
+			},
+			"listsigningcertificates": {
+				Name: "ListSigningCertificates",
+			},
+			"listuserpolicies": {
+				Name: "ListUserPolicies",
+			},
+			"listusertags": {
+				Name: "ListUserTags",
+			},
+			"listusers": {
+				Name: "ListUsers",
+			},
+			"listvirtualmfadevices": {
+				Name: "ListVirtualMFADevices",
+			},
+			"passrole": {
+				Name:          "PassRole",
+				ResourceTypes: []string{"role"},
+				ConditionKeys: []string{"iam:AssociatedResourceArn", "iam:PassedToService"},
+			},
+			"putgrouppolicy": {
+				Name: "PutGroupPolicy",
+			},
+			"putrolepermissionsboundary": {
+				Name: "PutRolePermissionsBoundary",
+			},
+			"putrolepolicy": {
+				Name:          "PutRolePolicy",
+				ResourceTypes: []string{"role"},
+				ConditionKeys: []string{"iam:PermissionsBoundary"},
+			},
+			"putuserpermissionsboundary": {
+				Name: "PutUserPermissionsBoundary",
+			},
+			"putuserpolicy": {
+				Name: "PutUserPolicy",
+			},
+			"removeclientidfromopenidconnectprovider": {
+				Name: "RemoveClientIDFromOpenIDConnectProvider",
+			},
+			"removerolefrominstanceprofile": {
+				Name: "RemoveRoleFromInstanceProfile",
+			},
+			"removeuserfromgroup": {
+				Name: "RemoveUserFromGroup",
+			},
+			"resetservicespecificcredential": {
+				Name: "ResetServiceSpecificCredential",
+			},
+			"resyncmfadevice": {
+				Name: "ResyncMFADevice",
+			},
+			"setdefaultpolicyversion": {
+				Name: "SetDefaultPolicyVersion",
+			},
+			"setsecuritytokenservicepreferences": {
+				Name: "SetSecurityTokenServicePreferences",
+			},
+			"simulatecustompolicy": {
+				Name: "SimulateCustomPolicy",
+			},
+			"simulateprincipalpolicy": {
+				Name: "SimulatePrincipalPolicy",
+			},
+			"taginstanceprofile": {
+				Name: "TagInstanceProfile",
+			},
+			"tagmfadevice": {
+				Name: "TagMFADevice",
+			},
+			"tagopenidconnectprovider": {
+				Name: "TagOpenIDConnectProvider",
+			},
+			"tagpolicy": {
+				Name: "TagPolicy",
+			},
+			"tagrole": {
+				Name: "TagRole",
+			},
+			"tagsamlprovider": {
+				Name: "TagSAMLProvider",
+			},
+			"tagservercertificate": {
+				Name: "TagServerCertificate",
+			},
+			"taguser": {
+				Name: "TagUser",
+			},
+			"untaginstanceprofile": {
+				Name: "UntagInstanceProfile",
+			},
+			"untagmfadevice": {
+				Name: "UntagMFADevice",
+			},
+			"untagopenidconnectprovider": {
+				Name: "UntagOpenIDConnectProvider",
+			},
+			"untagpolicy": {
+				Name: "UntagPolicy",
+			},
+			"untagrole": {
+				Name: "UntagRole",
+			},
+			"untagsamlprovider": {
+				Name: "UntagSAMLProvider",
+			},
+			"untagservercertificate": {
+				Name: "UntagServerCertificate",
+			},
+			"untaguser": {
+				Name: "UntagUser",
+			},
+			"updateaccesskey": {
+				Name: "UpdateAccessKey",
+			},
+			"updateaccountpasswordpolicy": {
+				Name: "UpdateAccountPasswordPolicy",
+			},
+			"updateassumerolepolicy": {
+				Name: "UpdateAssumeRolePolicy",
+			},
+			"updategroup": {
+				Name: "UpdateGroup",
+			},
+			"updateloginprofile": {
+				Name: "UpdateLoginProfile",
+			},
+			"updateopenidconnectproviderthumbprint": {
+				Name: "UpdateOpenIDConnectProviderThumbprint",
+			},
+			"updaterole": {
+				Name: "UpdateRole",
+			},
+			"updateroledescription": {
+				Name: "UpdateRoleDescription",
+			},
+			"updatesamlprovider": {
+				Name: "UpdateSAMLProvider",
+			},
+			"updatesshpublickey": {
+				Name: "UpdateSSHPublicKey",
+			},
+			"updateservercertificate": {
+				Name: "UpdateServerCertificate",
+			},
+			"updateservicespecificcredential": {
+				Name: "UpdateServiceSpecificCredential",
+			},
+			"updatesigningcertificate": {
+				Name: "UpdateSigningCertificate",
+			},
+			"updateuser": {
+				Name: "UpdateUser",
+			},
+			"uploadsshpublickey": {
+				Name: "UploadSSHPublicKey",
+			},
+			"uploadservercertificate": {
+				Name: "UploadServerCertificate",
+			},
+			"uploadsigningcertificate": {
+				Name: "UploadSigningCertificate",
+			},
+		},
+	},
+	"kms": {
+		Name:          "AWS Key Management Service",
+		Prefix:        "kms",
+		ConditionKeys: []string{"kms:CallerAccount", "kms:EncryptionAlgorithm", "kms:EncryptionContext:${EncryptionContextKey}", "kms:EncryptionContextKeys", "kms:GrantIsForAWSResource", "kms:KeyOrigin", "kms:KeySpec", "kms:KeyUsage", "kms:ViaService"},
+		Actions: map[string]*Action{
+			"cancelkeydeletion": {
+				Name: "CancelKeyDeletion",
+			},
+			"connectcustomkeystore": {
+				Name: "ConnectCustomKeyStore",
+			},
+			"createalias": {
+				Name: "CreateAlias",
+			},
+			"createcustomkeystore": {
+				Name: "CreateCustomKeyStore",
+			},
+			"creategrant": {
+				Name:          "CreateGrant",
+				ResourceTypes: []string{"key"},
+				ConditionKeys: []string{"kms:CallerAccount", "kms:GrantIsForAWSResource", "kms:ViaService"},
+			},
+			"createkey": {
+				Name: "CreateKey",
+			},
+			"decrypt": {
+				Name:          "Decrypt",
+				ResourceTypes: []string{"key"},
+				ConditionKeys: []string{"kms:CallerAccount", "kms:EncryptionAlgorithm", "kms:EncryptionContext:${EncryptionContextKey}", "kms:EncryptionContextKeys", "kms:ViaService"},
+			},
+			"deletealias": {
+				Name: "DeleteAlias",
+			},
+			"deletecustomkeystore": {
+				Name: "DeleteCustomKeyStore",
+			},
+			"deleteimportedkeymaterial": {
+				Name: "DeleteImportedKeyMaterial",
+			},
+			"describecustomkeystores": {
+				Name: "DescribeCustomKeyStores",
+			},
+			"describekey": {
+				Name: "DescribeKey",
+			},
+			"disablekey": {
+				Name: "DisableKey",
+			},
+			"disablekeyrotation": {
+				Name: "DisableKeyRotation",
+			},
+			"disconnectcustomkeystore": {
+				Name: "DisconnectCustomKeyStore",
+			},
+			"enablekey": {
+				Name: "EnableKey",
+			},
+			"enablekeyrotation": {
+				Name: "EnableKeyRotation",
+			},
+			"encrypt": {
+				Name:          "Encrypt",
+				ResourceTypes: []string{"key"},
+				ConditionKeys: []string{"kms:CallerAccount", "kms:EncryptionAlgorithm", "kms:EncryptionContext:${EncryptionContextKey}", "kms:EncryptionContextKeys", "kms:ViaService"},
+			},
+			"generatedatakey": {
+				Name:          "GenerateDataKey",
+				ResourceTypes: []string{"key"},
+				ConditionKeys: []string{"kms:CallerAccount", "kms:EncryptionContext:${EncryptionContextKey}", "kms:EncryptionContextKeys", "kms:ViaService"},
+			},
+			"generatedatakeypair": {
+				Name: "GenerateDataKeyPair",
+			},
+			"generatedatakeypairwithoutplaintext": {
+				Name: "GenerateDataKeyPairWithoutPlaintext",
+			},
+			"generatedatakeywithoutplaintext": {
+				Name: "GenerateDataKeyWithoutPlaintext",
+			},
+			"generatemac": {
+				Name: "GenerateMac",
+			},
+			"generaterandom": {
+				Name: "GenerateRandom",
+			},
+			"getkeypolicy": {
+				Name: "GetKeyPolicy",
+			},
+			"getkeyrotationstatus": {
+				Name: "GetKeyRotationStatus",
+			},
+			"getparametersforimport": {
+				Name: "GetParametersForImport",
+			},
+			"getpublickey": {
+				Name: "GetPublicKey",
+			},
+			"importkeymaterial": {
+				Name: "ImportKeyMaterial",
+			},
+			"listaliases": {
+				Name: "ListAliases",
+			},
+			"listgrants": {
+				Name: "ListGrants",
+			},
+			"listkeypolicies": {
+				Name: "ListKeyPolicies",
+			},
+			"listkeys": {
+				Name: "ListKeys",
+			},
+			"listresourcetags": {
+				Name: "ListResourceTags",
+			},
+			"listretirablegrants": {
+				Name: "ListRetirableGrants",
+			},
+			"putkeypolicy": {
+				Name: "PutKeyPolicy",
+			},
+			"reencrypt": {
+				Name: "ReEncrypt",
+			},
+			"reencryptfrom": {
+				Name: "ReEncryptFrom",
+			},
+			"reencryptto": {
+				Name: "ReEncryptTo",
+			},
+			"replicatekey": {
+				Name: "ReplicateKey",
+			},
+			"retiregrant": {
+				Name: "RetireGrant",
+			},
+			"revokegrant": {
+				Name: "RevokeGrant",
+			},
+			"schedulekeydeletion": {
+				Name: "ScheduleKeyDeletion",
+			},
+			"sign": {
+				Name: "Sign",
+			},
+			"tagresource": {
+				Name: "TagResource",
+			},
+			"untagresource": {
+				Name: "UntagResource",
+			},
+			"updatealias": {
+				Name: "UpdateAlias",
+			},
+			"updatecustomkeystore": {
+				Name: "UpdateCustomKeyStore",
+			},
+			"updatekeydescription": {
+				Name: "UpdateKeyDescription",
+			},
+			"updateprimaryregion": {
+				Name: "UpdatePrimaryRegion",
+			},
+			"verify": {
+				Name: "Verify",
+			},
+			"verifymac": {
+				Name: "VerifyMac",
+			},
+		},
+	},
+	"lambda": {
+		Name:          "AWS Lambda",
+		Prefix:        "lambda",
+		ConditionKeys: []string{"lambda:CodeSigningConfigArn", "lambda:FunctionArn", "lambda:FunctionUrlAuthType", "lambda:Layer", "lambda:Principal", "lambda:SecurityGroupIds", "lambda:SourceFunctionArn", "lambda:SubnetIds", "lambda:VpcIds"},
+		Actions: map[string]*Action{
+			"addlayerversionpermission": {
+				Name: "AddLayerVersionPermission",
+			},
+			"addpermission": {
+				Name:          "AddPermission",
+				ResourceTypes: []string{"function"},
+				ConditionKeys: []string{"lambda:Principal", "lambda:FunctionUrlAuthType"},
+			},
+			"createalias": {
+				Name: "CreateAlias",
+			},
+			"createcodesigningconfig": {
+				Name: "CreateCodeSigningConfig",
+			},
+			"createeventsourcemapping": {
+				Name: "CreateEventSourceMapping",
+			},
+			"createfunction": {
+				Name:          "CreateFunction",
+				ResourceTypes: []string{"function"},
+				ConditionKeys: []string{"lambda:Layer", "lambda:VpcIds", "lambda:SubnetIds", "lambda:SecurityGroupIds", "lambda:CodeSigningConfigArn"},
+			},
+			"createfunctionurlconfig": {
+				Name: "CreateFunctionUrlConfig",
+			},
+			"deletealias": {
+				Name: "DeleteAlias",
+			},
+			"deletecodesigningconfig": {
+				Name: "DeleteCodeSigningConfig",
+			},
+			"deleteeventsourcemapping": {
+				Name: "DeleteEventSourceMapping",
+			},
+			"deletefunction": {
+				Name: "DeleteFunction",
+			},
+			"deletefunctioncodesigningconfig": {
+				Name: "DeleteFunctionCodeSigningConfig",
+			},
+			"deletefunctionconcurrency": {
+				Name: "DeleteFunctionConcurrency",
+			},
+			"deletefunctioneventinvokeconfig": {
+				Name: "DeleteFunctionEventInvokeConfig",
+			},
+			"deletefunctionurlconfig": {
+				Name: "DeleteFunctionUrlConfig",
+			},
+			"deletelayerversion": {
+				Name: "DeleteLayerVersion",
+			},
+			"deleteprovisionedconcurrencyconfig": {
+				Name: "DeleteProvisionedConcurrencyConfig",
+			},
+			"disablereplication": {
+				Name: "DisableReplication",
+			},
+			"enablereplication": {
+				Name: "EnableReplication",
+			},
+			"getaccountsettings": {
+				Name: "GetAccountSettings",
+			},
+			"getalias": {
+				Name: "GetAlias",
+			},
+			"getcodesigningconfig": {
+				Name: "GetCodeSigningConfig",
+			},
+			"geteventsourcemapping": {
+				Name: "GetEventSourceMapping",
+			},
+			"getfunction": {
+				Name: "GetFunction",
+			},
+			"getfunctioncodesigningconfig": {
+				Name: "GetFunctionCodeSigningConfig",
+			},
+			"getfunctionconcurrency": {
+				Name: "GetFunctionConcurrency",
+			},
+			"getfunctionconfiguration": {
+				Name: "GetFunctionConfiguration",
+			},
+			"getfunctioneventinvokeconfig": {
+				Name: "GetFunctionEventInvokeConfig",
+			},
+			"getfunctionurlconfig": {
+				Name: "GetFunctionUrlConfig",
+			},
+			"getlayerversion": {
+				Name: "GetLayerVersion",
+			},
+			"getlayerversionbyarn": {
+				Name: "GetLayerVersionByArn",
+			},
+			"getlayerversionpolicy": {
+				Name: "GetLayerVersionPolicy",
+			},
+			"getpolicy": {
+				Name: "GetPolicy",
+			},
+			"getprovisionedconcurrencyconfig": {
+				Name: "GetProvisionedConcurrencyConfig",
+			},
+			"getruntimemanagementconfig": {
+				Name: "GetRuntimeManagementConfig",
+			},
+			"invokeasync": {
+				Name: "InvokeAsync",
+			},
+			"invokefunction": {
+				Name:          "InvokeFunction",
+				ResourceTypes: []string{"function"},
+			},
+			"invokefunctionurl": {
+				Name:          "InvokeFunctionUrl",
+				ResourceTypes: []string{"function"},
+				ConditionKeys: []string{"lambda:FunctionUrlAuthType"},
+			},
+			"listaliases": {
+				Name: "ListAliases",
+			},
+			"listcodesigningconfigs": {
+				Name: "ListCodeSigningConfigs",
+			},
+			"listeventsourcemappings": {
+				Name: "ListEventSourceMappings",
+			},
+			"listfunctioneventinvokeconfigs": {
+				Name: "ListFunctionEventInvokeConfigs",
+			},
+			"listfunctionurlconfigs": {
+				Name: "ListFunctionUrlConfigs",
+			},
+			"listfunctions": {
+				Name: "ListFunctions",
+			},
+			"listfunctionsbycodesigningconfig": {
+				Name: "ListFunctionsByCodeSigningConfig",
+			},
+			"listlayerversions": {
+				Name: "ListLayerVersions",
+			},
+			"listlayers": {
+				Name: "ListLayers",
+			},
+			"listprovisionedconcurrencyconfigs": {
+				Name: "ListProvisionedConcurrencyConfigs",
+			},
+			"listtags": {
+				Name: "ListTags",
+			},
+			"listversionsbyfunction": {
+				Name: "ListVersionsByFunction",
+			},
+			"publishlayerversion": {
+				Name: "PublishLayerVersion",
+			},
+			"publishversion": {
+				Name: "PublishVersion",
+			},
+			"putfunctioncodesigningconfig": {
+				Name: "PutFunctionCodeSigningConfig",
+			},
+			"putfunctionconcurrency": {
+				Name: "PutFunctionConcurrency",
+			},
+			"putfunctioneventinvokeconfig": {
+				Name: "PutFunctionEventInvokeConfig",
+			},
+			"putprovisionedconcurrencyconfig": {
+				Name: "PutProvisionedConcurrencyConfig",
+			},
+			"putruntimemanagementconfig": {
+				Name: "PutRuntimeManagementConfig",
+			},
+			"removelayerversionpermission": {
+				Name: "RemoveLayerVersionPermission",
+			},
+			"removepermission": {
+				Name: "RemovePermission",
+			},
+			"tagresource": {
+				Name: "TagResource",
+			},
+			"untagresource": {
+				Name: "UntagResource",
+			},
+			"updatealias": {
+				Name: "UpdateAlias",
+			},
+			"updatecodesigningconfig": {
+				Name: "UpdateCodeSigningConfig",
+			},
+			"updateeventsourcemapping": {
+				Name: "UpdateEventSourceMapping",
+			},
+			"updatefunctioncode": {
+				Name: "UpdateFunctionCode",
+			},
+			"updatefunctionconfiguration": {
+				Name: "UpdateFunctionConfiguration",
+			},
+			"updatefunctioneventinvokeconfig": {
+				Name: "UpdateFunctionEventInvokeConfig",
+			},
+			"updatefunctionurlconfig": {
+				Name: "UpdateFunctionUrlConfig",
+			},
+		},
+	},
+	"logs": {
+		Name:   "Amazon CloudWatch Logs",
+		Prefix: "logs",
+		Actions: map[string]*Action{
+			"associatekmskey": {
+				Name: "AssociateKmsKey",
+			},
+			"cancelexporttask": {
+				Name: "CancelExportTask",
+			},
+			"createexporttask": {
+				Name: "CreateExportTask",
+			},
+			"createloggroup": {
+				Name:          "CreateLogGroup",
+				ResourceTypes: []string{"log-group"},
+				ConditionKeys: []string{"aws:RequestTag/${TagKey}", "aws:TagKeys"},
+			},
+			"createlogstream": {
+				Name: "CreateLogStream",
+			},
+			"deleteaccountpolicy": {
+				Name: "DeleteAccountPolicy",
+			},
+			"deletedataprotectionpolicy": {
+				Name: "DeleteDataProtectionPolicy",
+			},
+			"deletedestination": {
+				Name: "DeleteDestination",
+			},
+			"deleteloggroup": {
+				Name: "DeleteLogGroup",
+			},
+			"deletelogstream": {
+				Name: "DeleteLogStream",
+			},
+			"deletemetricfilter": {
+				Name: "DeleteMetricFilter",
+			},
+			"deletequerydefinition": {
+				Name: "DeleteQueryDefinition",
+			},
+			"deleteresourcepolicy": {
+				Name: "DeleteResourcePolicy",
+			},
+			"deleteretentionpolicy": {
+				Name: "DeleteRetentionPolicy",
+			},
+			"deletesubscriptionfilter": {
+				Name: "DeleteSubscriptionFilter",
+			},
+			"describeaccountpolicies": {
+				Name: "DescribeAccountPolicies",
+			},
+			"describedestinations": {
+				Name: "DescribeDestinations",
+			},
+			"describeexporttasks": {
+				Name: "DescribeExportTasks",
+			},
+			"describeloggroups": {
+				Name: "DescribeLogGroups",
+			},
+			"describelogstreams": {
+				Name: "DescribeLogStreams",
+			},
+			"describemetricfilters": {
+				Name: "DescribeMetricFilters",
+			},
+			"describequeries": {
+				Name: "DescribeQueries",
+			},
+			"describequerydefinitions": {
+				Name: "DescribeQueryDefinitions",
+			},
+			"describeresourcepolicies": {
+				Name: "DescribeResourcePolicies",
+			},
+			"describesubscriptionfilters": {
+				Name: "DescribeSubscriptionFilters",
+			},
+			"disassociatekmskey": {
+				Name: "DisassociateKmsKey",
+			},
+			"filterlogevents": {
+				Name: "FilterLogEvents",
+			},
+			"getdataprotectionpolicy": {
+				Name: "GetDataProtectionPolicy",
+			},
+			"getlogevents": {
+				Name: "GetLogEvents",
+			},
+			"getloggroupfields": {
+				Name: "GetLogGroupFields",
+			},
+			"getlogrecord": {
+				Name: "GetLogRecord",
+			},
+			"getqueryresults": {
+				Name: "GetQueryResults",
+			},
+			"link": {
+				Name: "Link",
+			},
+			"listtagsforresource": {
+				Name: "ListTagsForResource",
+			},
+			"listtagsloggroup": {
+				Name: "ListTagsLogGroup",
+			},
+			"putaccountpolicy": {
+				Name: "PutAccountPolicy",
+			},
+			"putdataprotectionpolicy": {
+				Name: "PutDataProtectionPolicy",
+			},
+			"putdestination": {
+				Name: "PutDestination",
+			},
+			"putdestinationpolicy": {
+				Name: "PutDestinationPolicy",
+			},
+			"putlogevents": {
+				Name:          "PutLogEvents",
+				ResourceTypes: []string{"log-stream"},
+			},
+			"putmetricfilter": {
+				Name: "PutMetricFilter",
+			},
+			"putquerydefinition": {
+				Name: "PutQueryDefinition",
+			},
+			"putresourcepolicy": {
+				Name: "PutResourcePolicy",
+			},
+			"putretentionpolicy": {
+				Name: "PutRetentionPolicy",
+			},
+			"putsubscriptionfilter": {
+				Name: "PutSubscriptionFilter",
+			},
+			"startlivetail": {
+				Name: "StartLiveTail",
+			},
+			"startquery": {
+				Name: "StartQuery",
+			},
+			"stoplivetail": {
+				Name: "StopLiveTail",
+			},
+			"stopquery": {
+				Name: "StopQuery",
+			},
+			"tagloggroup": {
+				Name: "TagLogGroup",
+			},
+			"tagresource": {
+				Name: "TagResource",
+			},
+			"testmetricfilter": {
+				Name: "TestMetricFilter",
+			},
+			"unmask": {
+				Name: "Unmask",
+			},
+			"untagloggroup": {
+				Name: "UntagLogGroup",
+			},
+			"untagresource": {
+				Name: "UntagResource",
+			},
+		},
+	},
+	"s3": {
+		Name:          "Amazon S3",
+		Prefix:        "s3",
+		ConditionKeys: []string{"s3:AccessPointNetworkOrigin", "s3:DataAccessPointAccount", "s3:DataAccessPointArn", "s3:ResourceAccount", "s3:TlsVersion", "s3:authType", "s3:signatureAge", "s3:signatureversion", "s3:x-amz-content-sha256"},
+		Actions: map[string]*Action{
+			"abortmultipartupload": {
+				Name:          "AbortMultipartUpload",
+				ResourceTypes: []string{"object"},
+			},
+			"bypassgovernanceretention": {
+				Name:          "BypassGovernanceRetention",
+				ResourceTypes: []string{"object"},
+			},
+			"createaccesspoint": {
+				Name:          "CreateAccessPoint",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"createaccesspointforobjectlambda": {
+				Name:          "CreateAccessPointForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"createbucket": {
+				Name:          "CreateBucket",
+				ConditionKeys: []string{"s3:LocationConstraint", "s3:x-amz-acl", "s3:x-amz-object-ownership"},
+			},
+			"createjob": {
+				Name: "CreateJob",
+			},
+			"createmultiregionaccesspoint": {
+				Name: "CreateMultiRegionAccessPoint",
+			},
+			"deleteaccesspoint": {
+				Name:          "DeleteAccessPoint",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"deleteaccesspointforobjectlambda": {
+				Name:          "DeleteAccessPointForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"deleteaccesspointpolicy": {
+				Name:          "DeleteAccessPointPolicy",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"deleteaccesspointpolicyforobjectlambda": {
+				Name:          "DeleteAccessPointPolicyForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"deletebucket": {
+				Name:          "DeleteBucket",
+				ResourceTypes: []string{"bucket"},
+			},
+			"deletebucketownershipcontrols": {
+				Name:          "DeleteBucketOwnershipControls",
+				ResourceTypes: []string{"bucket"},
+			},
+			"deletebucketpolicy": {
+				Name:          "DeleteBucketPolicy",
+				ResourceTypes: []string{"bucket"},
+			},
+			"deletebucketwebsite": {
+				Name:          "DeleteBucketWebsite",
+				ResourceTypes: []string{"bucket"},
+			},
+			"deletejobtagging": {
+				Name:          "DeleteJobTagging",
+				ResourceTypes: []string{"job"},
+			},
+			"deletemultiregionaccesspoint": {
+				Name:          "DeleteMultiRegionAccessPoint",
+				ResourceTypes: []string{"multiregionaccesspoint"},
+			},
+			"deleteobject": {
+				Name:          "DeleteObject",
+				ResourceTypes: []string{"object"},
+				ConditionKeys: []string{"s3:authType", "s3:signatureversion", "s3:TlsVersion"},
+			},
+			"deleteobjecttagging": {
+				Name:          "DeleteObjectTagging",
+				ResourceTypes: []string{"object"},
+			},
+			"deleteobjectversion": {
+				Name:          "DeleteObjectVersion",
+				ResourceTypes: []string{"object"},
+			},
+			"deleteobjectversiontagging": {
+				Name:          "DeleteObjectVersionTagging",
+				ResourceTypes: []string{"object"},
+			},
+			"deletestoragelensconfiguration": {
+				Name:          "DeleteStorageLensConfiguration",
+				ResourceTypes: []string{"storagelensconfiguration"},
+			},
+			"deletestoragelensconfigurationtagging": {
+				Name:          "DeleteStorageLensConfigurationTagging",
+				ResourceTypes: []string{"storagelensconfiguration"},
+			},
+			"describejob": {
+				Name:          "DescribeJob",
+				ResourceTypes: []string{"job"},
+			},
+			"describemultiregionaccesspointoperation": {
+				Name: "DescribeMultiRegionAccessPointOperation",
+			},
+			"getaccelerateconfiguration": {
+				Name:          "GetAccelerateConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getaccesspoint": {
+				Name:          "GetAccessPoint",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"getaccesspointconfigurationforobjectlambda": {
+				Name:          "GetAccessPointConfigurationForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"getaccesspointforobjectlambda": {
+				Name:          "GetAccessPointForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"getaccesspointpolicy": {
+				Name:          "GetAccessPointPolicy",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"getaccesspointpolicyforobjectlambda": {
+				Name:          "GetAccessPointPolicyForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"getaccesspointpolicystatus": {
+				Name:          "GetAccessPointPolicyStatus",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"getaccesspointpolicystatusforobjectlambda": {
+				Name:          "GetAccessPointPolicyStatusForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"getaccountpublicaccessblock": {
+				Name: "GetAccountPublicAccessBlock",
+			},
+			"getanalyticsconfiguration": {
+				Name:          "GetAnalyticsConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketacl": {
+				Name:          "GetBucketAcl",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketcors": {
+				Name:          "GetBucketCORS",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketlocation": {
+				Name:          "GetBucketLocation",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketlogging": {
+				Name:          "GetBucketLogging",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketnotification": {
+				Name:          "GetBucketNotification",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketobjectlockconfiguration": {
+				Name:          "GetBucketObjectLockConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketownershipcontrols": {
+				Name:          "GetBucketOwnershipControls",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketpolicy": {
+				Name:          "GetBucketPolicy",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketpolicystatus": {
+				Name:          "GetBucketPolicyStatus",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketpublicaccessblock": {
+				Name:          "GetBucketPublicAccessBlock",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketrequestpayment": {
+				Name:          "GetBucketRequestPayment",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbuckettagging": {
+				Name:          "GetBucketTagging",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketversioning": {
+				Name:          "GetBucketVersioning",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getbucketwebsite": {
+				Name:          "GetBucketWebsite",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getencryptionconfiguration": {
+				Name:          "GetEncryptionConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getintelligenttieringconfiguration": {
+				Name:          "GetIntelligentTieringConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getinventoryconfiguration": {
+				Name:          "GetInventoryConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getjobtagging": {
+				Name:          "GetJobTagging",
+				ResourceTypes: []string{"job"},
+			},
+			"getlifecycleconfiguration": {
+				Name:          "GetLifecycleConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getmetricsconfiguration": {
+				Name:          "GetMetricsConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getmultiregionaccesspoint": {
+				Name:          "GetMultiRegionAccessPoint",
+				ResourceTypes: []string{"multiregionaccesspoint"},
+			},
+			"getmultiregionaccesspointpolicy": {
+				Name:          "GetMultiRegionAccessPointPolicy",
+				ResourceTypes: []string{"multiregionaccesspoint"},
+			},
+			"getmultiregionaccesspointpolicystatus": {
+				Name:          "GetMultiRegionAccessPointPolicyStatus",
+				ResourceTypes: []string{"multiregionaccesspoint"},
+			},
+			"getmultiregionaccesspointroutes": {
+				Name:          "GetMultiRegionAccessPointRoutes",
+				ResourceTypes: []string{"multiregionaccesspoint"},
+			},
+			"getobject": {
+				Name:          "GetObject",
+				ResourceTypes: []string{"object"},
+				ConditionKeys: []string{"s3:ExistingObjectTag/${TagKey}", "s3:authType", "s3:signatureversion", "s3:TlsVersion"},
+			},
+			"getobjectacl": {
+				Name:          "GetObjectAcl",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjectattributes": {
+				Name:          "GetObjectAttributes",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjectlegalhold": {
+				Name:          "GetObjectLegalHold",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjectretention": {
+				Name:          "GetObjectRetention",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjecttagging": {
+				Name:          "GetObjectTagging",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjecttorrent": {
+				Name:          "GetObjectTorrent",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjectversion": {
+				Name:          "GetObjectVersion",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjectversionacl": {
+				Name:          "GetObjectVersionAcl",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjectversionattributes": {
+				Name:          "GetObjectVersionAttributes",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjectversionforreplication": {
+				Name:          "GetObjectVersionForReplication",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjectversiontagging": {
+				Name:          "GetObjectVersionTagging",
+				ResourceTypes: []string{"object"},
+			},
+			"getobjectversiontorrent": {
+				Name:          "GetObjectVersionTorrent",
+				ResourceTypes: []string{"object"},
+			},
+			"getreplicationconfiguration": {
+				Name:          "GetReplicationConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"getstoragelensconfiguration": {
+				Name:          "GetStorageLensConfiguration",
+				ResourceTypes: []string{"storagelensconfiguration"},
+			},
+			"getstoragelensconfigurationtagging": {
+				Name:          "GetStorageLensConfigurationTagging",
+				ResourceTypes: []string{"storagelensconfiguration"},
+			},
+			"getstoragelensdashboard": {
+				Name:          "GetStorageLensDashboard",
+				ResourceTypes: []string{"storagelensconfiguration"},
+			},
+			"initiatereplication": {
+				Name: "InitiateReplication",
+			},
+			"listaccesspoints": {
+				Name:          "ListAccessPoints",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"listaccesspointsforobjectlambda": {
+				Name:          "ListAccessPointsForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"listallmybuckets": {
+				Name: "ListAllMyBuckets",
+			},
+			"listbucket": {
+				Name:          "ListBucket",
+				ResourceTypes: []string{"bucket"},
+				ConditionKeys: []string{"s3:prefix", "s3:delimiter", "s3:max-keys", "s3:ListBucketVersions"},
+			},
+			"listbucketmultipartuploads": {
+				Name:          "ListBucketMultipartUploads",
+				ResourceTypes: []string{"bucket"},
+			},
+			"listbucketversions": {
+				Name:          "ListBucketVersions",
+				ResourceTypes: []string{"bucket"},
+				ConditionKeys: []string{"s3:prefix", "s3:delimiter", "s3:max-keys"},
+			},
+			"listjobs": {
+				Name: "ListJobs",
+			},
+			"listmultiregionaccesspoints": {
+				Name: "ListMultiRegionAccessPoints",
+			},
+			"listmultipartuploadparts": {
+				Name:          "ListMultipartUploadParts",
+				ResourceTypes: []string{"object"},
+			},
+			"liststoragelensconfigurations": {
+				Name: "ListStorageLensConfigurations",
+			},
+			"objectowneroverridetobucketowner": {
+				Name:          "ObjectOwnerOverrideToBucketOwner",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putaccelerateconfiguration": {
+				Name:          "PutAccelerateConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putaccesspointconfigurationforobjectlambda": {
+				Name:          "PutAccessPointConfigurationForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"putaccesspointpolicy": {
+				Name:          "PutAccessPointPolicy",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"putaccesspointpolicyforobjectlambda": {
+				Name:          "PutAccessPointPolicyForObjectLambda",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"putaccesspointpublicaccessblock": {
+				Name:          "PutAccessPointPublicAccessBlock",
+				ResourceTypes: []string{"accesspoint"},
+			},
+			"putaccountpublicaccessblock": {
+				Name: "PutAccountPublicAccessBlock",
+			},
+			"putanalyticsconfiguration": {
+				Name:          "PutAnalyticsConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketacl": {
+				Name:          "PutBucketAcl",
+				ResourceTypes: []string{"bucket"},
+				ConditionKeys: []string{"s3:x-amz-acl", "s3:x-amz-grant-read", "s3:x-amz-grant-write", "s3:x-amz-grant-full-control"},
+			},
+			"putbucketcors": {
+				Name:          "PutBucketCORS",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketlogging": {
+				Name:          "PutBucketLogging",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketnotification": {
+				Name:          "PutBucketNotification",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketobjectlockconfiguration": {
+				Name:          "PutBucketObjectLockConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketownershipcontrols": {
+				Name:          "PutBucketOwnershipControls",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketpolicy": {
+				Name:          "PutBucketPolicy",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketpublicaccessblock": {
+				Name:          "PutBucketPublicAccessBlock",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketrequestpayment": {
+				Name:          "PutBucketRequestPayment",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbuckettagging": {
+				Name:          "PutBucketTagging",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketversioning": {
+				Name:          "PutBucketVersioning",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putbucketwebsite": {
+				Name:          "PutBucketWebsite",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putencryptionconfiguration": {
+				Name:          "PutEncryptionConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putintelligenttieringconfiguration": {
+				Name:          "PutIntelligentTieringConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putinventoryconfiguration": {
+				Name:          "PutInventoryConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putjobtagging": {
+				Name:          "PutJobTagging",
+				ResourceTypes: []string{"job"},
+			},
+			"putlifecycleconfiguration": {
+				Name:          "PutLifecycleConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putmetricsconfiguration": {
+				Name:          "PutMetricsConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putmultiregionaccesspointpolicy": {
+				Name:          "PutMultiRegionAccessPointPolicy",
+				ResourceTypes: []string{"multiregionaccesspoint"},
+			},
+			"putobject": {
+				Name:          "PutObject",
+				ResourceTypes: []string{"object"},
+				ConditionKeys: []string{"s3:RequestObjectTag/${TagKey}", "s3:RequestObjectTagKeys", "s3:x-amz-acl", "s3:x-amz-server-side-encryption", "s3:x-amz-server-side-encryption-aws-kms-key-id", "s3:x-amz-storage-class", "s3:object-lock-mode", "s3:object-lock-retain-until-date", "s3:object-lock-legal-hold"},
+			},
+			"putobjectacl": {
+				Name:          "PutObjectAcl",
+				ResourceTypes: []string{"object"},
+				ConditionKeys: []string{"s3:ExistingObjectTag/${TagKey}", "s3:x-amz-acl", "s3:x-amz-grant-read", "s3:x-amz-grant-write", "s3:x-amz-grant-full-control"},
+			},
+			"putobjectlegalhold": {
+				Name:          "PutObjectLegalHold",
+				ResourceTypes: []string{"object"},
+			},
+			"putobjectretention": {
+				Name:          "PutObjectRetention",
+				ResourceTypes: []string{"object"},
+			},
+			"putobjecttagging": {
+				Name:          "PutObjectTagging",
+				ResourceTypes: []string{"object"},
+			},
+			"putobjectversionacl": {
+				Name:          "PutObjectVersionAcl",
+				ResourceTypes: []string{"object"},
+			},
+			"putobjectversiontagging": {
+				Name:          "PutObjectVersionTagging",
+				ResourceTypes: []string{"object"},
+			},
+			"putreplicationconfiguration": {
+				Name:          "PutReplicationConfiguration",
+				ResourceTypes: []string{"bucket"},
+			},
+			"putstoragelensconfiguration": {
+				Name:          "PutStorageLensConfiguration",
+				ResourceTypes: []string{"storagelensconfiguration"},
+			},
+			"putstoragelensconfigurationtagging": {
+				Name:          "PutStorageLensConfigurationTagging",
+				ResourceTypes: []string{"storagelensconfiguration"},
+			},
+			"replicatedelete": {
+				Name:          "ReplicateDelete",
+				ResourceTypes: []string{"object"},
+			},
+			"replicateobject": {
+				Name:          "ReplicateObject",
+				ResourceTypes: []string{"object"},
+			},
+			"replicatetags": {
+				Name:          "ReplicateTags",
+				ResourceTypes: []string{"object"},
+			},
+			"restoreobject": {
+				Name:          "RestoreObject",
+				ResourceTypes: []string{"object"},
+			},
+			"submitmultiregionaccesspointroutes": {
+				Name:          "SubmitMultiRegionAccessPointRoutes",
+				ResourceTypes: []string{"multiregionaccesspoint"},
+			},
+			"updatejobpriority": {
+				Name:          "UpdateJobPriority",
+				ResourceTypes: []string{"job"},
+			},
+			"updatejobstatus": {
+				Name:          "UpdateJobStatus",
+				ResourceTypes: []string{"job"},
+			},
+		},
+	},
+	"secretsmanager": {
+		Name:          "AWS Secrets Manager",
+		Prefix:        "secretsmanager",
+		ConditionKeys: []string{"secretsmanager:Name", "secretsmanager:Description", "secretsmanager:KmsKeyId", "secretsmanager:ResourceTag/${TagKey}", "secretsmanager:SecretId", "secretsmanager:VersionId", "secretsmanager:VersionStage"},
+		Actions: map[string]*Action{
+			"cancelrotatesecret": {
+				Name: "CancelRotateSecret",
+			},
+			"createsecret": {
+				Name: "CreateSecret",
+			},
+			"deleteresourcepolicy": {
+				Name: "DeleteResourcePolicy",
+			},
+			"deletesecret": {
+				Name: "DeleteSecret",
+			},
+			"describesecret": {
+				Name: "DescribeSecret",
+			},
+			"getrandompassword": {
+				Name: "GetRandomPassword",
+			},
+			"getresourcepolicy": {
+				Name: "GetResourcePolicy",
+			},
+			"getsecretvalue": {
+				Name:          "GetSecretValue",
+				ResourceTypes: []string{"Secret"},
+				ConditionKeys: []string{"secretsmanager:SecretId", "secretsmanager:VersionId", "secretsmanager:VersionStage", "secretsmanager:ResourceTag/${TagKey}"},
+			},
+			"listsecretversionids": {
+				Name: "ListSecretVersionIds",
+			},
+			"listsecrets": {
+				Name: "ListSecrets",
+			},
+			"putresourcepolicy": {
+				Name: "PutResourcePolicy",
+			},
+			"putsecretvalue": {
+				Name: "PutSecretValue",
+			},
+			"removeregionsfromreplication": {
+				Name: "RemoveRegionsFromReplication",
+			},
+			"replicatesecrettoregions": {
+				Name: "ReplicateSecretToRegions",
+			},
+			"restoresecret": {
+				Name: "RestoreSecret",
+			},
+			"rotatesecret": {
+				Name: "RotateSecret",
+			},
+			"stopreplicationtoreplica": {
+				Name: "StopReplicationToReplica",
+			},
+			"tagresource": {
+				Name: "TagResource",
+			},
+			"untagresource": {
+				Name: "UntagResource",
+			},
+			"updatesecret": {
+				Name: "UpdateSecret",
+			},
+			"updatesecretversionstage": {
+				Name: "UpdateSecretVersionStage",
+			},
+			"validateresourcepolicy": {
+				Name: "ValidateResourcePolicy",
+			},
+		},
+	},
+	"sns": {
+		Name:          "Amazon Simple Notification Service",
+		Prefix:        "sns",
+		ConditionKeys: []string{"sns:Endpoint", "sns:Protocol"},
+		Actions: map[string]*Action{
+			"addpermission": {
+				Name: "AddPermission",
+			},
+			"checkifphonenumberisoptedout": {
+				Name: "CheckIfPhoneNumberIsOptedOut",
+			},
+			"confirmsubscription": {
+				Name: "ConfirmSubscription",
+			},
+			"createplatformapplication": {
+				Name: "CreatePlatformApplication",
+			},
+			"createplatformendpoint": {
+				Name: "CreatePlatformEndpoint",
+			},
+			"createsmssandboxphonenumber": {
+				Name: "CreateSMSSandboxPhoneNumber",
+			},
+			"createtopic": {
+				Name: "CreateTopic",
+			},
+			"deleteendpoint": {
+				Name: "DeleteEndpoint",
+			},
+			"deleteplatformapplication": {
+				Name: "DeletePlatformApplication",
+			},
+			"deletesmssandboxphonenumber": {
+				Name: "DeleteSMSSandboxPhoneNumber",
+			},
+			"deletetopic": {
+				Name: "DeleteTopic",
+			},
+			"getdataprotectionpolicy": {
+				Name: "GetDataProtectionPolicy",
+			},
+			"getendpointattributes": {
+				Name: "GetEndpointAttributes",
+			},
+			"getplatformapplicationattributes": {
+				Name: "GetPlatformApplicationAttributes",
+			},
+			"getsmsattributes": {
+				Name: "GetSMSAttributes",
+			},
+			"getsmssandboxaccountstatus": {
+				Name: "GetSMSSandboxAccountStatus",
+			},
+			"getsubscriptionattributes": {
+				Name: "GetSubscriptionAttributes",
+			},
+			"gettopicattributes": {
+				Name: "GetTopicAttributes",
+			},
+			"listendpointsbyplatformapplication": {
+				Name: "ListEndpointsByPlatformApplication",
+			},
+			"listoriginationnumbers": {
+				Name: "ListOriginationNumbers",
+			},
+			"listphonenumbersoptedout": {
+				Name: "ListPhoneNumbersOptedOut",
+			},
+			"listplatformapplications": {
+				Name: "ListPlatformApplications",
+			},
+			"listsmssandboxphonenumbers": {
+				Name: "ListSMSSandboxPhoneNumbers",
+			},
+			"listsubscriptions": {
+				Name: "ListSubscriptions",
+			},
+			"listsubscriptionsbytopic": {
+				Name: "ListSubscriptionsByTopic",
+			},
+			"listtagsforresource": {
+				Name: "ListTagsForResource",
+			},
+			"listtopics": {
+				Name: "ListTopics",
+			},
+			"optinphonenumber": {
+				Name: "OptInPhoneNumber",
+			},
+			"publish": {
+				Name:          "Publish",
+				ResourceTypes: []string{"topic"},
+			},
+			"publishbatch": {
+				Name: "PublishBatch",
+			},
+			"putdataprotectionpolicy": {
+				Name: "PutDataProtectionPolicy",
+			},
+			"removepermission": {
+				Name: "RemovePermission",
+			},
+			"setendpointattributes": {
+				Name: "SetEndpointAttributes",
+			},
+			"setplatformapplicationattributes": {
+				Name: "SetPlatformApplicationAttributes",
+			},
+			"setsmsattributes": {
+				Name: "SetSMSAttributes",
+			},
+			"setsubscriptionattributes": {
+				Name: "SetSubscriptionAttributes",
+			},
+			"settopicattributes": {
+				Name: "SetTopicAttributes",
+			},
+			"subscribe": {
+				Name:          "Subscribe",
+				ResourceTypes: []string{"topic"},
+				ConditionKeys: []string{"sns:Endpoint", "sns:Protocol"},
+			},
+			"tagresource": {
+				Name: "TagResource",
+			},
+			"unsubscribe": {
+				Name: "Unsubscribe",
+			},
+			"untagresource": {
+				Name: "UntagResource",
+			},
+			"verifysmssandboxphonenumber": {
+				Name: "VerifySMSSandboxPhoneNumber",
+			},
+		},
+	},
+	"sqs": {
+		Name:   "Amazon Simple Queue Service",
+		Prefix: "sqs",
+		Actions: map[string]*Action{
+			"addpermission": {
+				Name: "AddPermission",
+			},
+			"cancelmessagemovetask": {
+				Name: "CancelMessageMoveTask",
+			},
+			"changemessagevisibility": {
+				Name: "ChangeMessageVisibility",
+			},
+			"changemessagevisibilitybatch": {
+				Name: "ChangeMessageVisibilityBatch",
+			},
+			"createqueue": {
+				Name: "CreateQueue",
+			},
+			"deletemessage": {
+				Name:          "DeleteMessage",
+				ResourceTypes: []string{"queue"},
+			},
+			"deletemessagebatch": {
+				Name: "DeleteMessageBatch",
+			},
+			"deletequeue": {
+				Name: "DeleteQueue",
+			},
+			"getqueueattributes": {
+				Name: "GetQueueAttributes",
+			},
+			"getqueueurl": {
+				Name: "GetQueueUrl",
+			},
+			"listdeadlettersourcequeues": {
+				Name: "ListDeadLetterSourceQueues",
+			},
+			"listmessagemovetasks": {
+				Name: "ListMessageMoveTasks",
+			},
+			"listqueuetags": {
+				Name: "ListQueueTags",
+			},
+			"listqueues": {
+				Name: "ListQueues",
+			},
+			"purgequeue": {
+				Name: "PurgeQueue",
+			},
+			"receivemessage": {
+				Name:          "ReceiveMessage",
+				ResourceTypes: []string{"queue"},
+			},
+			"removepermission": {
+				Name: "RemovePermission",
+			},
+			"sendmessage": {
+				Name:          "SendMessage",
+				ResourceTypes: []string{"queue"},
+			},
+			"sendmessagebatch": {
+				Name: "SendMessageBatch",
+			},
+			"setqueueattributes": {
+				Name: "SetQueueAttributes",
+			},
+			"startmessagemovetask": {
+				Name: "StartMessageMoveTask",
+			},
+			"tagqueue": {
+				Name: "TagQueue",
+			},
+			"untagqueue": {
+				Name: "UntagQueue",
+			},
+		},
+	},
+	"ssm": {
+		Name:          "Amazon Simple Systems Manager (SSM)",
+		Prefix:        "ssm",
+		ConditionKeys: []string{"ssm:Overwrite", "ssm:Recursive", "ssm:SessionDocumentAccessCheck", "ssm:resourceTag/${TagKey}"},
+		Actions: map[string]*Action{
+			"addtagstoresource": {
+				Name: "AddTagsToResource",
+			},
+			"associateopsitemrelateditem": {
+				Name: "AssociateOpsItemRelatedItem",
+			},
+			"cancelcommand": {
+				Name: "CancelCommand",
+			},
+			"cancelmaintenancewindowexecution": {
+				Name: "CancelMaintenanceWindowExecution",
+			},
+			"createactivation": {
+				Name: "CreateActivation",
+			},
+			"createassociation": {
+				Name: "CreateAssociation",
+			},
+			"createassociationbatch": {
+				Name: "CreateAssociationBatch",
+			},
+			"createdocument": {
+				Name: "CreateDocument",
+			},
+			"createmaintenancewindow": {
+				Name: "CreateMaintenanceWindow",
+			},
+			"createopsitem": {
+				Name: "CreateOpsItem",
+			},
+			"createopsmetadata": {
+				Name: "CreateOpsMetadata",
+			},
+			"createpatchbaseline": {
+				Name: "CreatePatchBaseline",
+			},
+			"createresourcedatasync": {
+				Name: "CreateResourceDataSync",
+			},
+			"deleteactivation": {
+				Name: "DeleteActivation",
+			},
+			"deleteassociation": {
+				Name: "DeleteAssociation",
+			},
+			"deletedocument": {
+				Name: "DeleteDocument",
+			},
+			"deleteinventory": {
+				Name: "DeleteInventory",
+			},
+			"deletemaintenancewindow": {
+				Name: "DeleteMaintenanceWindow",
+			},
+			"deleteopsmetadata": {
+				Name: "DeleteOpsMetadata",
+			},
+			"deleteparameter": {
+				Name: "DeleteParameter",
+			},
+			"deleteparameters": {
+				Name: "DeleteParameters",
+			},
+			"deletepatchbaseline": {
+				Name: "DeletePatchBaseline",
+			},
+			"deleteresourcedatasync": {
+				Name: "DeleteResourceDataSync",
+			},
+			"deleteresourcepolicy": {
+				Name: "DeleteResourcePolicy",
+			},
+			"deregistermanagedinstance": {
+				Name: "DeregisterManagedInstance",
+			},
+			"deregisterpatchbaselineforpatchgroup": {
+				Name: "DeregisterPatchBaselineForPatchGroup",
+			},
+			"deregistertargetfrommaintenancewindow": {
+				Name: "DeregisterTargetFromMaintenanceWindow",
+			},
+			"deregistertaskfrommaintenancewindow": {
+				Name: "DeregisterTaskFromMaintenanceWindow",
+			},
+			"describeactivations": {
+				Name: "DescribeActivations",
+			},
+			"describeassociation": {
+				Name: "DescribeAssociation",
+			},
+			"describeassociationexecutiontargets": {
+				Name: "DescribeAssociationExecutionTargets",
+			},
+			"describeassociationexecutions": {
+				Name: "DescribeAssociationExecutions",
+			},
+			"describeautomationexecutions": {
+				Name: "DescribeAutomationExecutions",
+			},
+			"describeautomationstepexecutions": {
+				Name: "DescribeAutomationStepExecutions",
+			},
+			"describeavailablepatches": {
+				Name: "DescribeAvailablePatches",
+			},
+			"describedocument": {
+				Name: "DescribeDocument",
+			},
+			"describedocumentpermission": {
+				Name: "DescribeDocumentPermission",
+			},
+			"describeeffectiveinstanceassociations": {
+				Name: "DescribeEffectiveInstanceAssociations",
+			},
+			"describeeffectivepatchesforpatchbaseline": {
+				Name: "DescribeEffectivePatchesForPatchBaseline",
+			},
+			"describeinstanceassociationsstatus": {
+				Name: "DescribeInstanceAssociationsStatus",
+			},
+			"describeinstanceinformation": {
+				Name: "DescribeInstanceInformation",
+			},
+			"describeinstancepatchstates": {
+				Name: "DescribeInstancePatchStates",
+			},
+			"describeinstancepatchstatesforpatchgroup": {
+				Name: "DescribeInstancePatchStatesForPatchGroup",
+			},
+			"describeinstancepatches": {
+				Name: "DescribeInstancePatches",
+			},
+			"describeinventorydeletions": {
+				Name: "DescribeInventoryDeletions",
+			},
+			"describemaintenancewindowexecutiontaskinvocations": {
+				Name: "DescribeMaintenanceWindowExecutionTaskInvocations",
+			},
+			"describemaintenancewindowexecutiontasks": {
+				Name: "DescribeMaintenanceWindowExecutionTasks",
+			},
+			"describemaintenancewindowexecutions": {
+				Name: "DescribeMaintenanceWindowExecutions",
+			},
+			"describemaintenancewindowschedule": {
+				Name: "DescribeMaintenanceWindowSchedule",
+			},
+			"describemaintenancewindowtargets": {
+				Name: "DescribeMaintenanceWindowTargets",
+			},
+			"describemaintenancewindowtasks": {
+				Name: "DescribeMaintenanceWindowTasks",
+			},
+			"describemaintenancewindows": {
+				Name: "DescribeMaintenanceWindows",
+			},
+			"describemaintenancewindowsfortarget": {
+				Name: "DescribeMaintenanceWindowsForTarget",
+			},
+			"describeopsitems": {
+				Name: "DescribeOpsItems",
+			},
+			"describeparameters": {
+				Name: "DescribeParameters",
+			},
+			"describepatchbaselines": {
+				Name: "DescribePatchBaselines",
+			},
+			"describepatchgroupstate": {
+				Name: "DescribePatchGroupState",
+			},
+			"describepatchgroups": {
+				Name: "DescribePatchGroups",
+			},
+			"describepatchproperties": {
+				Name: "DescribePatchProperties",
+			},
+			"describesessions": {
+				Name: "DescribeSessions",
+			},
+			"disassociateopsitemrelateditem": {
+				Name: "DisassociateOpsItemRelatedItem",
+			},
+			"getautomationexecution": {
+				Name: "GetAutomationExecution",
+			},
+			"getcalendarstate": {
+				Name: "GetCalendarState",
+			},
+			"getcommandinvocation": {
+				Name: "GetCommandInvocation",
+			},
+			"getconnectionstatus": {
+				Name: "GetConnectionStatus",
+			},
+			"getdefaultpatchbaseline": {
+				Name: "GetDefaultPatchBaseline",
+			},
+			"getdeployablepatchsnapshotforinstance": {
+				Name: "GetDeployablePatchSnapshotForInstance",
+			},
+			"getdocument": {
+				Name: "GetDocument",
+			},
+			"getinventory": {
+				Name: "GetInventory",
+			},
+			"getinventoryschema": {
+				Name: "GetInventorySchema",
+			},
+			"getmaintenancewindow": {
+				Name: "GetMaintenanceWindow",
+			},
+			"getmaintenancewindowexecution": {
+				Name: "GetMaintenanceWindowExecution",
+			},
+			"getmaintenancewindowexecutiontask": {
+				Name: "GetMaintenanceWindowExecutionTask",
+			},
+			"getmaintenancewindowexecutiontaskinvocation": {
+				Name: "GetMaintenanceWindowExecutionTaskInvocation",
+			},
+			"getmaintenancewindowtask": {
+				Name: "GetMaintenanceWindowTask",
+			},
+			"getopsitem": {
+				Name: "GetOpsItem",
+			},
+			"getopsmetadata": {
+				Name: "GetOpsMetadata",
+			},
+			"getopssummary": {
+				Name: "GetOpsSummary",
+			},
+			"getparameter": {
+				Name:          "GetParameter",
+				ResourceTypes: []string{"parameter"},
+			},
+			"getparameterhistory": {
+				Name: "GetParameterHistory",
+			},
+			"getparameters": {
+				Name: "GetParameters",
+			},
+			"getparametersbypath": {
+				Name:          "GetParametersByPath",
+				ResourceTypes: []string{"parameter"},
+				ConditionKeys: []string{"ssm:Recursive"},
+			},
+			"getpatchbaseline": {
+				Name: "GetPatchBaseline",
+			},
+			"getpatchbaselineforpatchgroup": {
+				Name: "GetPatchBaselineForPatchGroup",
+			},
+			"getresourcepolicies": {
+				Name: "GetResourcePolicies",
+			},
+			"getservicesetting": {
+				Name: "GetServiceSetting",
+			},
+			"labelparameterversion": {
+				Name: "LabelParameterVersion",
+			},
+			"listassociationversions": {
+				Name: "ListAssociationVersions",
+			},
+			"listassociations": {
+				Name: "ListAssociations",
+			},
+			"listcommandinvocations": {
+				Name: "ListCommandInvocations",
+			},
+			"listcommands": {
+				Name: "ListCommands",
+			},
+			"listcomplianceitems": {
+				Name: "ListComplianceItems",
+			},
+			"listcompliancesummaries": {
+				Name: "ListComplianceSummaries",
+			},
+			"listdocumentmetadatahistory": {
+				Name: "ListDocumentMetadataHistory",
+			},
+			"listdocumentversions": {
+				Name: "ListDocumentVersions",
+			},
+			"listdocuments": {
+				Name: "ListDocuments",
+			},
+			"listinventoryentries": {
+				Name: "ListInventoryEntries",
+			},
+			"listopsitemevents": {
+				Name: "ListOpsItemEvents",
+			},
+			"listopsitemrelateditems": {
+				Name: "ListOpsItemRelatedItems",
+			},
+			"listopsmetadata": {
+				Name: "ListOpsMetadata",
+			},
+			"listresourcecompliancesummaries": {
+				Name: "ListResourceComplianceSummaries",
+			},
+			"listresourcedatasync": {
+				Name: "ListResourceDataSync",
+			},
+			"listtagsforresource": {
+				Name: "ListTagsForResource",
+			},
+			"modifydocumentpermission": {
+				Name: "ModifyDocumentPermission",
+			},
+			"putcomplianceitems": {
+				Name: "PutComplianceItems",
+			},
+			"putinventory": {
+				Name: "PutInventory",
+			},
+			"putparameter": {
+				Name:          "PutParameter",
+				ResourceTypes: []string{"parameter"},
+				ConditionKeys: []string{"ssm:Overwrite"},
+			},
+			"putresourcepolicy": {
+				Name: "PutResourcePolicy",
+			},
+			"registerdefaultpatchbaseline": {
+				Name: "RegisterDefaultPatchBaseline",
+			},
+			"registerpatchbaselineforpatchgroup": {
+				Name: "RegisterPatchBaselineForPatchGroup",
+			},
+			"registertargetwithmaintenancewindow": {
+				Name: "RegisterTargetWithMaintenanceWindow",
+			},
+			"registertaskwithmaintenancewindow": {
+				Name: "RegisterTaskWithMaintenanceWindow",
+			},
+			"removetagsfromresource": {
+				Name: "RemoveTagsFromResource",
+			},
+			"resetservicesetting": {
+				Name: "ResetServiceSetting",
+			},
+			"resumesession": {
+				Name: "ResumeSession",
+			},
+			"sendautomationsignal": {
+				Name: "SendAutomationSignal",
+			},
+			"sendcommand": {
+				Name: "SendCommand",
+			},
+			"startassociationsonce": {
+				Name: "StartAssociationsOnce",
+			},
+			"startautomationexecution": {
+				Name: "StartAutomationExecution",
+			},
+			"startchangerequestexecution": {
+				Name: "StartChangeRequestExecution",
+			},
+			"startsession": {
+				Name:          "StartSession",
+				ResourceTypes: []string{"document", "instance"},
+				ConditionKeys: []string{"ssm:SessionDocumentAccessCheck"},
+			},
+			"stopautomationexecution": {
+				Name: "StopAutomationExecution",
+			},
+			"terminatesession": {
+				Name: "TerminateSession",
+			},
+			"unlabelparameterversion": {
+				Name: "UnlabelParameterVersion",
+			},
+			"updateassociation": {
+				Name: "UpdateAssociation",
+			},
+			"updateassociationstatus": {
+				Name: "UpdateAssociationStatus",
+			},
+			"updatedocument": {
+				Name: "UpdateDocument",
+			},
+			"updatedocumentdefaultversion": {
+				Name: "UpdateDocumentDefaultVersion",
+			},
+			"updatedocumentmetadata": {
+				Name: "UpdateDocumentMetadata",
+			},
+			"updatemaintenancewindow": {
+				Name: "UpdateMaintenanceWindow",
+			},
+			"updatemaintenancewindowtarget": {
+				Name: "UpdateMaintenanceWindowTarget",
+			},
+			"updatemaintenancewindowtask": {
+				Name: "UpdateMaintenanceWindowTask",
+			},
+			"updatemanagedinstancerole": {
+				Name: "UpdateManagedInstanceRole",
+			},
+			"updateopsitem": {
+				Name: "UpdateOpsItem",
+			},
+			"updateopsmetadata": {
+				Name: "UpdateOpsMetadata",
+			},
+			"updatepatchbaseline": {
+				Name: "UpdatePatchBaseline",
+			},
+			"updateresourcedatasync": {
+				Name: "UpdateResourceDataSync",
+			},
+			"updateservicesetting": {
+				Name: "UpdateServiceSetting",
+			},
+		},
+	},
+	"sts": {
+		Name:          "AWS Security Token Service",
+		Prefix:        "sts",
+		ConditionKeys: []string{"sts:ExternalId", "sts:RoleSessionName", "sts:SourceIdentity", "sts:TransitiveTagKeys", "accounts.google.com:aud", "cognito-identity.amazonaws.com:aud", "graph.facebook.com:app_id", "www.amazon.com:app_id"},
+		Actions: map[string]*Action{
+			"assumerole": {
+				Name:          "AssumeRole",
+				ResourceTypes: []string{"role"},
+				ConditionKeys: []string{"aws:PrincipalTag/${TagKey}", "aws:RequestTag/${TagKey}", "aws:TagKeys", "sts:ExternalId", "sts:RoleSessionName", "sts:SourceIdentity", "sts:TransitiveTagKeys"},
+			},
+			"assumerolewithsaml": {
+				Name:          "AssumeRoleWithSAML",
+				ResourceTypes: []string{"role"},
+				ConditionKeys: []string{"saml:aud", "saml:sub", "saml:iss", "sts:SourceIdentity"},
+			},
+			"assumerolewithwebidentity": {
+				Name:          "AssumeRoleWithWebIdentity",
+				ResourceTypes: []string{"role"},
+				ConditionKeys: []string{"sts:RoleSessionName", "sts:SourceIdentity"},
+			},
+			"decodeauthorizationmessage": {
+				Name: "DecodeAuthorizationMessage",
+			},
+			"getaccesskeyinfo": {
+				Name: "GetAccessKeyInfo",
+			},
+			"getcalleridentity": {
+				Name: "GetCallerIdentity",
+			},
+			"getfederationtoken": {
+				Name: "GetFederationToken",
+			},
+			"getservicebearertoken": {
+				Name: "GetServiceBearerToken",
+			},
+			"getsessiontoken": {
+				Name: "GetSessionToken",
+			},
+			"setsourceidentity": {
+				Name: "SetSourceIdentity",
+			},
+			"tagsession": {
+				Name: "TagSession",
+			},
+		},
+	},
+}
@@ -0,0 +1,223 @@
+// +build generators
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	source   = "actions.json"
+	filename = "actions.go"
+	// serviceReferenceURL is the endpoint of the machine-readable Service Authorization Reference
+	// https://docs.aws.amazon.com/service-authorization/latest/reference/service-reference.html
+	serviceReferenceURL = "https://servicereference.us-east-1.amazonaws.com/"
+)
+
+type catalog struct {
+	Services []service `json:"services"`
+}
+
+type service struct {
+	Name          string   `json:"name"`
+	Prefix        string   `json:"prefix"`
+	ConditionKeys []string `json:"condition_keys"`
+	Actions       []action `json:"actions"`
+}
+
+type action struct {
+	Name          string   `json:"name"`
+	ResourceTypes []string `json:"resource_types,omitempty"`
+	ConditionKeys []string `json:"condition_keys,omitempty"`
+}
+
+// serviceReference is a service in the Service Authorization Reference
+type serviceReference struct {
+	Name    string `json:"Name"`
+	Actions []struct {
+		Name                string   `json:"Name"`
+		ActionConditionKeys []string `json:"ActionConditionKeys"`
+		Resources           []struct {
+			Name string `json:"Name"`
+		} `json:"Resources"`
+	} `json:"Actions"`
+	ConditionKeys []struct {
+		Name string `json:"Name"`
+	} `json:"ConditionKeys"`
+}
+
+func main() {
+	update := flag.Bool("update", false, "update the actions of the services in "+source+" from the Service Authorization Reference")
+	flag.Parse()
+
+	src, err := os.ReadFile(source)
+	if err != nil {
+		log.Fatalf("error reading file (%s): %v", source, err)
+	}
+
+	var data catalog
+	if err := json.Unmarshal(src, &data); err != nil {
+		log.Fatalf("error parsing file (%s): %v", source, err)
+	}
+
+	if *update {
+		if err := updateCatalog(&data); err != nil {
+			log.Fatalf("error updating catalog: %v", err)
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, service := range data.Services {
+		if seen[service.Prefix] {
+			log.Fatalf("duplicate service prefix: %s", service.Prefix)
+		}
+		seen[service.Prefix] = true
+
+		actions := map[string]bool{}
+		for _, action := range service.Actions {
+			key := strings.ToLower(action.Name)
+			if actions[key] {
+				log.Fatalf("duplicate action: %s:%s", service.Prefix, action.Name)
+			}
+			actions[key] = true
+		}
+		sort.Slice(service.Actions, func(i, j int) bool { return service.Actions[i].Name < service.Actions[j].Name })
+	}
+	sort.Slice(data.Services, func(i, j int) bool { return data.Services[i].Prefix < data.Services[j].Prefix })
+
+	tpl, err := template.New("actions").Funcs(template.FuncMap{"lower": strings.ToLower}).Parse(templateBody)
+	if err != nil {
+		log.Fatalf("error parsing template: %v", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tpl.Execute(&buffer, data); err != nil {
+		log.Fatalf("error executing template: %v", err)
+	}
+
+	if *update {
+		out, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			log.Fatalf("error encoding catalog: %v", err)
+		}
+		if err := os.WriteFile(source, append(out, '\n'), 0644); err != nil {
+			log.Fatalf("error writing to file (%s): %v", source, err)
+		}
+	}
+
+	formatted, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatalf("error formatting generated file: %v", err)
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		log.Fatalf("error creating file (%s): %v", filename, err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(formatted)
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %v", filename, err)
+	}
+}
+
+// updateCatalog replaces the actions and condition keys of the services in the catalog
+// with the ones in the Service Authorization Reference. Service names are kept.
+func updateCatalog(data *catalog) error {
+	var index []struct {
+		Service string `json:"service"`
+		URL     string `json:"url"`
+	}
+	if err := fetchJSON(serviceReferenceURL, &index); err != nil {
+		return err
+	}
+	urls := map[string]string{}
+	for _, entry := range index {
+		urls[entry.Service] = entry.URL
+	}
+
+	for i, service := range data.Services {
+		url, exists := urls[service.Prefix]
+		if !exists {
+			return fmt.Errorf("service not found in the Service Authorization Reference: %s", service.Prefix)
+		}
+
+		var reference serviceReference
+		if err := fetchJSON(url, &reference); err != nil {
+			return err
+		}
+
+		conditionKeys := make([]string, len(reference.ConditionKeys))
+		for j, key := range reference.ConditionKeys {
+			conditionKeys[j] = key.Name
+		}
+		sort.Strings(conditionKeys)
+		data.Services[i].ConditionKeys = conditionKeys
+
+		actions := make([]action, len(reference.Actions))
+		for j, referenceAction := range reference.Actions {
+			actions[j] = action{Name: referenceAction.Name, ConditionKeys: referenceAction.ActionConditionKeys}
+			for _, resource := range referenceAction.Resources {
+				actions[j].ResourceTypes = append(actions[j].ResourceTypes, resource.Name)
+			}
+		}
+		sort.Slice(actions, func(i, j int) bool { return actions[i].Name < actions[j].Name })
+		data.Services[i].Actions = actions
+	}
+
+	return nil
+}
+
+func fetchJSON(url string, v interface{}) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from %s: %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+const templateBody = `
+// Code generated by generator/main.go; DO NOT EDIT.
+package iam
+
+var services = map[string]*Service{
+	{{- range .Services }}
+	"{{ .Prefix }}": {
+		Name:   "{{ .Name }}",
+		Prefix: "{{ .Prefix }}",
+		{{- if .ConditionKeys }}
+		ConditionKeys: []string{ {{- range .ConditionKeys }}"{{ . }}", {{ end -}} },
+		{{- end }}
+		Actions: map[string]*Action{
+			{{- range .Actions }}
+			"{{ lower .Name }}": {
+				Name: "{{ .Name }}",
+				{{- if .ResourceTypes }}
+				ResourceTypes: []string{ {{- range .ResourceTypes }}"{{ . }}", {{ end -}} },
+				{{- end }}
+				{{- if .ConditionKeys }}
+				ConditionKeys: []string{ {{- range .ConditionKeys }}"{{ . }}", {{ end -}} },
+				{{- end }}
+			},
+			{{- end }}
+		},
+	},
+	{{- end }}
+}
+`
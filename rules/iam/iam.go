@@ -0,0 +1,112 @@
+//go:generate go run -tags generators ./generator/main.go
+
+package iam
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/agext/levenshtein"
+)
+
+// maxSuggestionDistance is the maximum edit distance for an action name to be suggested
+const maxSuggestionDistance = 3
+
+// Service is an AWS service that can be used in IAM policies
+type Service struct {
+	Name          string
+	Prefix        string
+	ConditionKeys []string
+	// Actions are keyed by the lowercase action name because action names are case-insensitive
+	Actions map[string]*Action
+}
+
+// Action is an action that can be granted to a principal in IAM policies
+type Action struct {
+	Name          string
+	ResourceTypes []string
+	ConditionKeys []string
+}
+
+// LookupService returns the service for the passed prefix (e.g. "s3")
+func LookupService(prefix string) (*Service, bool) {
+	service, ok := services[strings.ToLower(prefix)]
+	return service, ok
+}
+
+// LookupAction returns the action for the passed name (e.g. "GetObject")
+func (s *Service) LookupAction(name string) (*Action, bool) {
+	action, ok := s.Actions[strings.ToLower(name)]
+	return action, ok
+}
+
+// MatchActions returns the actions matched by the passed pattern (e.g. "Get*") sorted by name
+func (s *Service) MatchActions(pattern string) []*Action {
+	pattern = strings.ToLower(pattern)
+
+	matched := []*Action{}
+	for key, action := range s.Actions {
+		if MatchPattern(pattern, key) {
+			matched = append(matched, action)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].Name < matched[j].Name })
+	return matched
+}
+
+// ActionNames returns the names of all actions of the service sorted by name
+func (s *Service) ActionNames() []string {
+	names := make([]string, 0, len(s.Actions))
+	for _, action := range s.Actions {
+		names = append(names, action.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SuggestAction returns the name of the action closest to the passed name, or an empty string if nothing is close enough
+func (s *Service) SuggestAction(name string) string {
+	name = strings.ToLower(name)
+
+	suggestion := ""
+	minDistance := maxSuggestionDistance + 1
+	for _, candidate := range s.ActionNames() {
+		distance := levenshtein.Distance(name, strings.ToLower(candidate), nil)
+		if distance < minDistance {
+			suggestion = candidate
+			minDistance = distance
+		}
+	}
+	return suggestion
+}
+
+// MatchPattern reports whether the value matches the IAM wildcard pattern.
+// IAM patterns support `*` (any sequence) and `?` (any single character).
+// The comparison is case-sensitive, so callers should normalize the case if necessary.
+func MatchPattern(pattern, value string) bool {
+	p, v := 0, 0
+	// star is the position of the last `*` in the pattern, and next is the position in the value to retry from
+	star, next := -1, 0
+
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, v
+			p++
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case star >= 0:
+			// Let the last `*` consume one more character
+			next++
+			p, v = star+1, next
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -0,0 +1,72 @@
+package iam
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_MatchPattern(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Pattern  string
+		Value    string
+		Expected bool
+	}{
+		{
+			Name:     "exact match",
+			Pattern:  "s3:getobject",
+			Value:    "s3:getobject",
+			Expected: true,
+		},
+		{
+			Name:     "wildcard",
+			Pattern:  "s3:get*",
+			Value:    "s3:getobject",
+			Expected: true,
+		},
+		{
+			Name:     "wildcard in the middle",
+			Pattern:  "s3:*object",
+			Value:    "s3:getobject",
+			Expected: true,
+		},
+		{
+			Name:     "single character",
+			Pattern:  "s3:?etobject",
+			Value:    "s3:getobject",
+			Expected: true,
+		},
+		{
+			Name:     "empty wildcard",
+			Pattern:  "s3:getobject*",
+			Value:    "s3:getobject",
+			Expected: true,
+		},
+		{
+			Name:     "different value",
+			Pattern:  "s3:put*",
+			Value:    "s3:getobject",
+			Expected: false,
+		},
+		{
+			Name:     "single character does not match empty",
+			Pattern:  "s3:getobject?",
+			Value:    "s3:getobject",
+			Expected: false,
+		},
+		{
+			Name:     "many wildcards",
+			Pattern:  strings.Repeat("*a", 30) + "b",
+			Value:    strings.Repeat("a", 100),
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := MatchPattern(tc.Pattern, tc.Value); got != tc.Expected {
+				t.Fatalf(`MatchPattern("%s", "%s") = %t, want %t`, tc.Pattern, tc.Value, got, tc.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,550 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/rules/iam"
+)
+
+// iamPolicyResourceTypes are resources that declare an IAM policy as a JSON string in the `policy` attribute
+var iamPolicyResourceTypes = []string{
+	"aws_iam_policy",
+	"aws_iam_role_policy",
+	"aws_iam_user_policy",
+	"aws_iam_group_policy",
+}
+
+const iamPolicyDocumentDataSourceType = "aws_iam_policy_document"
+
+// iamPolicyDocumentStatementSchema is a schema of `statement` blocks in `aws_iam_policy_document` data sources
+var iamPolicyDocumentStatementSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "sid"},
+		{Name: "effect"},
+		{Name: "actions"},
+		{Name: "not_actions"},
+		{Name: "resources"},
+		{Name: "not_resources"},
+	},
+	Blocks: []hclext.BlockSchema{
+		{
+			Type: "principals",
+			Body: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: "type"}, {Name: "identifiers"}},
+			},
+		},
+		{
+			Type: "not_principals",
+			Body: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: "type"}, {Name: "identifiers"}},
+			},
+		},
+		{
+			Type: "condition",
+			Body: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: "test"}, {Name: "variable"}, {Name: "values"}},
+			},
+		},
+	},
+}
+
+// iamPolicyDocument is a parsed IAM policy document
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_grammar.html
+type iamPolicyDocument struct {
+	Version   string                `json:"Version,omitempty"`
+	Id        string                `json:"Id,omitempty"`
+	Statement []*iamPolicyStatement `json:"Statement"`
+}
+
+// iamPolicyStatement is a statement in an IAM policy document
+type iamPolicyStatement struct {
+	Sid          string                                `json:"Sid,omitempty"`
+	Effect       string                                `json:"Effect,omitempty"`
+	Action       iamPolicyValues                       `json:"Action,omitempty"`
+	NotAction    iamPolicyValues                       `json:"NotAction,omitempty"`
+	Resource     iamPolicyValues                       `json:"Resource,omitempty"`
+	NotResource  iamPolicyValues                       `json:"NotResource,omitempty"`
+	Principal    iamPolicyPrincipal                    `json:"Principal,omitempty"`
+	NotPrincipal iamPolicyPrincipal                    `json:"NotPrincipal,omitempty"`
+	Condition    map[string]map[string]iamPolicyValues `json:"Condition,omitempty"`
+
+	// Range is the location of the statement in the configuration.
+	// For JSON policies, this is the range of the whole policy expression.
+	Range hcl.Range `json:"-"`
+	// UnknownEffect is true if the effect of a `statement` block cannot be evaluated.
+	// The effect is left empty in this case, so that rules skip the statement.
+	UnknownEffect bool `json:"-"`
+}
+
+// iamPolicyValues is a list of strings that can be declared as either a single value or an array
+type iamPolicyValues []string
+
+// iamPolicyPrincipal maps principal types to identifiers.
+// The anonymous principal (`"Principal": "*"`) is represented as the "*" type with the "*" identifier.
+type iamPolicyPrincipal map[string]iamPolicyValues
+
+// UnmarshalJSON accepts both a single statement and an array of statements
+func (d *iamPolicyDocument) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Version   string          `json:"Version"`
+		Id        string          `json:"Id"`
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	d.Version = raw.Version
+	d.Id = raw.Id
+
+	if len(raw.Statement) == 0 {
+		return nil
+	}
+	// If the Statement clause includes only one value, you can omit the brackets.
+	if err := json.Unmarshal(raw.Statement, &d.Statement); err != nil {
+		statement := &iamPolicyStatement{}
+		if err := json.Unmarshal(raw.Statement, statement); err != nil {
+			return err
+		}
+		d.Statement = []*iamPolicyStatement{statement}
+	}
+	return nil
+}
+
+// UnmarshalJSON accepts a string, a number, a boolean or an array of them
+func (v *iamPolicyValues) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch val := raw.(type) {
+	case nil:
+		*v = nil
+	case []interface{}:
+		values := make(iamPolicyValues, len(val))
+		for i, item := range val {
+			values[i] = fmt.Sprint(item)
+		}
+		*v = values
+	case map[string]interface{}:
+		return fmt.Errorf("unexpected object in IAM policy: %s", string(data))
+	default:
+		*v = iamPolicyValues{fmt.Sprint(val)}
+	}
+	return nil
+}
+
+// MarshalJSON writes a single value as a string like the `json` attribute of aws_iam_policy_document
+func (v iamPolicyValues) MarshalJSON() ([]byte, error) {
+	if len(v) == 1 {
+		return json.Marshal(v[0])
+	}
+	return json.Marshal([]string(v))
+}
+
+// MarshalJSON writes the anonymous principal as "*"
+func (p iamPolicyPrincipal) MarshalJSON() ([]byte, error) {
+	if len(p) == 1 && len(p["*"]) == 1 && p["*"][0] == "*" {
+		return json.Marshal("*")
+	}
+	return json.Marshal(map[string]iamPolicyValues(p))
+}
+
+// UnmarshalJSON accepts both the "*" wildcard and a map of principal types
+func (p *iamPolicyPrincipal) UnmarshalJSON(data []byte) error {
+	var wildcard string
+	if err := json.Unmarshal(data, &wildcard); err == nil {
+		*p = iamPolicyPrincipal{wildcard: iamPolicyValues{wildcard}}
+		return nil
+	}
+
+	principal := map[string]iamPolicyValues{}
+	if err := json.Unmarshal(data, &principal); err != nil {
+		return err
+	}
+	*p = principal
+	return nil
+}
+
+// Contains returns whether the values include the passed value
+func (v iamPolicyValues) Contains(value string) bool {
+	for _, item := range v {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIAMPolicyDocument parses a JSON policy document
+func parseIAMPolicyDocument(src string) (*iamPolicyDocument, error) {
+	document := &iamPolicyDocument{}
+	if err := json.Unmarshal([]byte(src), document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// IsAllow returns whether the statement allows access
+func (s *iamPolicyStatement) IsAllow() bool {
+	return s.Effect == "Allow"
+}
+
+// HasCondition returns whether the statement has any condition
+func (s *iamPolicyStatement) HasCondition() bool {
+	return len(s.Condition) > 0
+}
+
+// iamActionMatch reports whether the action pattern grants the passed action
+func iamActionMatch(pattern, action string) bool {
+	return iam.MatchPattern(strings.ToLower(pattern), strings.ToLower(action))
+}
+
+// walkIAMPolicyDocuments visits every statement of IAM policies declared in IAM policy resources
+// and `aws_iam_policy_document` data sources
+func walkIAMPolicyDocuments(runner tflint.Runner, walker func(*iamPolicyStatement) error) error {
+	for _, resourceType := range iamPolicyResourceTypes {
+		// Statements of data sources are visited below, so references to them are not resolved
+		if err := walkIAMPolicyAttribute(runner, resourceType, "policy", nil, walker); err != nil {
+			return err
+		}
+	}
+
+	return walkIAMPolicyDocumentDataSources(runner, walker)
+}
+
+// walkIAMPolicyAttribute visits every statement of the JSON policies declared in the passed resource attribute.
+// References to `aws_iam_policy_document` data sources are resolved with the passed rendered documents.
+// Policies that are not valid JSON are ignored.
+func walkIAMPolicyAttribute(runner tflint.Runner, resourceType, attributeName string, documents map[string]string, walker func(*iamPolicyStatement) error) error {
+	resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[attributeName]
+		if !exists {
+			continue
+		}
+
+		walkPolicy := func(policy string) error {
+			document, err := parseIAMPolicyDocument(policy)
+			if err != nil {
+				logger.Debug("Failed to parse the policy of %s.%s: %s", resource.Labels[0], resource.Labels[1], err)
+				return nil
+			}
+
+			for _, statement := range document.Statement {
+				statement.Range = attribute.Expr.Range()
+				if err := walker(statement); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if name, ok := iamPolicyDocumentDataSourceReference(attribute.Expr); ok && documents != nil {
+			if document, exists := documents[name]; exists {
+				if err := walkPolicy(document); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := runner.EvaluateExpr(attribute.Expr, walkPolicy, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkIAMPolicyDocumentDataSources visits every `statement` block in `aws_iam_policy_document` data sources
+func walkIAMPolicyDocumentDataSources(runner tflint.Runner, walker func(*iamPolicyStatement) error) error {
+	dataSources, err := getIAMPolicyDocumentDataSources(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, dataSource := range dataSources {
+		for _, block := range dataSource.Body.Blocks.OfType(statementBlockName) {
+			statement, err := decodeIAMPolicyDocumentStatement(runner, block)
+			if err != nil {
+				return err
+			}
+			if err := walker(statement); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getIAMPolicyDocumentDataSources returns all `aws_iam_policy_document` data sources in the module
+func getIAMPolicyDocumentDataSources(runner tflint.Runner) (hclext.Blocks, error) {
+	body, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "version"},
+						{Name: "policy_id"},
+						{Name: "source_json"},
+						{Name: "override_json"},
+						{Name: "source_policy_documents"},
+						{Name: "override_policy_documents"},
+					},
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: statementBlockName,
+							Body: iamPolicyDocumentStatementSchema,
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	dataSources := hclext.Blocks{}
+	for _, block := range body.Blocks {
+		if block.Labels[0] == iamPolicyDocumentDataSourceType {
+			dataSources = append(dataSources, block)
+		}
+	}
+	return dataSources, nil
+}
+
+// renderIAMPolicyDocumentDataSources renders the `json` attribute of `aws_iam_policy_document` data sources.
+// The result is keyed by data source names. Data sources that merge other documents are not rendered.
+func renderIAMPolicyDocumentDataSources(runner tflint.Runner) (map[string]string, error) {
+	dataSources, err := getIAMPolicyDocumentDataSources(runner)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := map[string]string{}
+	for _, dataSource := range dataSources {
+		mergeable := false
+		for _, name := range []string{"source_json", "override_json", "source_policy_documents", "override_policy_documents"} {
+			if _, exists := dataSource.Body.Attributes[name]; exists {
+				mergeable = true
+			}
+		}
+		if mergeable {
+			continue
+		}
+
+		// The version defaults to "2012-10-17" in aws_iam_policy_document
+		document := &iamPolicyDocument{Version: "2012-10-17", Statement: []*iamPolicyStatement{}}
+		known := true
+		for name, target := range map[string]*string{"version": &document.Version, "policy_id": &document.Id} {
+			attr, exists := dataSource.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+			target := target
+			evaluated := false
+			if err := runner.EvaluateExpr(attr.Expr, func(val string) error {
+				*target = val
+				evaluated = true
+				return nil
+			}, nil); err != nil {
+				return nil, err
+			}
+			known = known && evaluated
+		}
+		if !known {
+			continue
+		}
+
+		for _, block := range dataSource.Body.Blocks.OfType(statementBlockName) {
+			statement, err := decodeIAMPolicyDocumentStatement(runner, block)
+			if err != nil {
+				return nil, err
+			}
+			document.Statement = append(document.Statement, statement)
+		}
+
+		out, err := json.Marshal(document)
+		if err != nil {
+			return nil, err
+		}
+		rendered[dataSource.Labels[1]] = string(out)
+	}
+
+	return rendered, nil
+}
+
+// iamPolicyDocumentDataSourceReference returns the data source name if the expression is a reference to
+// the `json` attribute of an `aws_iam_policy_document` data source
+func iamPolicyDocumentDataSourceReference(expr hcl.Expression) (string, bool) {
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() || len(traversal) != 4 || traversal.RootName() != "data" {
+		return "", false
+	}
+
+	dataSourceType, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok || dataSourceType.Name != iamPolicyDocumentDataSourceType {
+		return "", false
+	}
+	name, ok := traversal[2].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	attribute, ok := traversal[3].(hcl.TraverseAttr)
+	if !ok || attribute.Name != "json" {
+		return "", false
+	}
+	return name.Name, true
+}
+
+// decodeIAMPolicyDocumentStatement converts a `statement` block to the same representation as JSON policies.
+// Values that cannot be evaluated are left empty.
+func decodeIAMPolicyDocumentStatement(runner tflint.Runner, block *hclext.Block) (*iamPolicyStatement, error) {
+	statement := &iamPolicyStatement{
+		// The effect defaults to "Allow" in aws_iam_policy_document
+		Effect: "Allow",
+		Range:  block.DefRange,
+	}
+
+	if attr, exists := block.Body.Attributes["sid"]; exists {
+		if err := runner.EvaluateExpr(attr.Expr, func(sid string) error {
+			statement.Sid = sid
+			return nil
+		}, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if attr, exists := block.Body.Attributes["effect"]; exists {
+		statement.Effect = ""
+		statement.UnknownEffect = true
+		if err := runner.EvaluateExpr(attr.Expr, func(effect string) error {
+			statement.Effect = effect
+			statement.UnknownEffect = false
+			return nil
+		}, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	lists := map[string]*iamPolicyValues{
+		"actions":       &statement.Action,
+		"not_actions":   &statement.NotAction,
+		"resources":     &statement.Resource,
+		"not_resources": &statement.NotResource,
+	}
+	for name, target := range lists {
+		attr, exists := block.Body.Attributes[name]
+		if !exists {
+			continue
+		}
+		target := target
+		if err := runner.EvaluateExpr(attr.Expr, func(values []string) error {
+			*target = values
+			return nil
+		}, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, principals := range block.Body.Blocks {
+		var target *iamPolicyPrincipal
+		switch principals.Type {
+		case "principals":
+			target = &statement.Principal
+		case "not_principals":
+			target = &statement.NotPrincipal
+		default:
+			continue
+		}
+
+		typeAttr, typeExists := principals.Body.Attributes["type"]
+		identifiersAttr, identifiersExists := principals.Body.Attributes["identifiers"]
+		if !typeExists || !identifiersExists {
+			continue
+		}
+
+		var principalType string
+		if err := runner.EvaluateExpr(typeAttr.Expr, func(val string) error {
+			principalType = val
+			return nil
+		}, nil); err != nil {
+			return nil, err
+		}
+		if principalType == "" {
+			continue
+		}
+
+		if err := runner.EvaluateExpr(identifiersAttr.Expr, func(identifiers []string) error {
+			if *target == nil {
+				*target = iamPolicyPrincipal{}
+			}
+			(*target)[principalType] = append((*target)[principalType], identifiers...)
+			return nil
+		}, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, condition := range block.Body.Blocks.OfType("condition") {
+		testAttr, testExists := condition.Body.Attributes["test"]
+		variableAttr, variableExists := condition.Body.Attributes["variable"]
+		if !testExists || !variableExists {
+			continue
+		}
+
+		var test, variable string
+		if err := runner.EvaluateExpr(testAttr.Expr, func(val string) error {
+			test = val
+			return nil
+		}, nil); err != nil {
+			return nil, err
+		}
+		if err := runner.EvaluateExpr(variableAttr.Expr, func(val string) error {
+			variable = val
+			return nil
+		}, nil); err != nil {
+			return nil, err
+		}
+		if test == "" {
+			continue
+		}
+
+		var values iamPolicyValues
+		if valuesAttr, exists := condition.Body.Attributes["values"]; exists {
+			if err := runner.EvaluateExpr(valuesAttr.Expr, func(val []string) error {
+				values = val
+				return nil
+			}, nil); err != nil {
+				return nil, err
+			}
+		}
+
+		if statement.Condition == nil {
+			statement.Condition = map[string]map[string]iamPolicyValues{}
+		}
+		if statement.Condition[test] == nil {
+			statement.Condition[test] = map[string]iamPolicyValues{}
+		}
+		statement.Condition[test][variable] = append(statement.Condition[test][variable], values...)
+	}
+
+	return statement, nil
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Resource types of Elastic Load Balancing and their aws_alb_* aliases
+var (
+	lbResourceTypes             = []string{"aws_lb", "aws_alb"}
+	lbListenerResourceTypes     = []string{"aws_lb_listener", "aws_alb_listener"}
+	lbListenerRuleResourceTypes = []string{"aws_lb_listener_rule", "aws_alb_listener_rule"}
+	lbTargetGroupResourceTypes  = []string{"aws_lb_target_group", "aws_alb_target_group"}
+)
+
+// lbProtocols maps load balancer types to the protocols of their listeners and target groups
+var lbProtocols = map[string][]string{
+	"application": {"HTTP", "HTTPS"},
+	"network":     {"TCP", "TLS", "UDP", "TCP_UDP"},
+	"gateway":     {"GENEVE"},
+}
+
+// lbReference returns the address of the resource that the expression refers to.
+// Any of the resource types is accepted, so references to aliases are resolved as well.
+func lbReference(expr hcl.Expression, resourceTypes []string, attributes ...string) (string, bool) {
+	for _, resourceType := range resourceTypes {
+		if address, ok := resourceReference(expr, resourceType, attributes...); ok {
+			return address, true
+		}
+	}
+	return "", false
+}
+
+// lbEvaluateString returns the string value of the attribute, or an empty string if it is not declared or cannot be evaluated
+func lbEvaluateString(runner tflint.Runner, body *hclext.BodyContent, name string) (string, error) {
+	attribute, exists := body.Attributes[name]
+	if !exists {
+		return "", nil
+	}
+
+	value := ""
+	err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+		value = val
+		return nil
+	}, nil)
+	return value, err
+}
+
+// lbTypes returns load balancer types keyed by the addresses of aws_lb and aws_alb.
+// The type is empty if load_balancer_type cannot be evaluated.
+func lbTypes(runner tflint.Runner) (map[string]string, error) {
+	types := map[string]string{}
+
+	for _, resourceType := range lbResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "load_balancer_type"}},
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources.Blocks {
+			loadBalancerType := "application"
+			if _, exists := resource.Body.Attributes["load_balancer_type"]; exists {
+				if loadBalancerType, err = lbEvaluateString(runner, resource.Body, "load_balancer_type"); err != nil {
+					return nil, err
+				}
+			}
+			types[fmt.Sprintf("%s.%s", resourceType, resource.Labels[1])] = loadBalancerType
+		}
+	}
+
+	return types, nil
+}
+
+// lbTargetGroupProtocols returns upper-cased protocols keyed by the addresses of aws_lb_target_group and aws_alb_target_group.
+// Target groups without known protocols are omitted.
+func lbTargetGroupProtocols(runner tflint.Runner) (map[string]string, error) {
+	protocols := map[string]string{}
+
+	for _, resourceType := range lbTargetGroupResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "protocol"}},
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources.Blocks {
+			protocol, err := lbEvaluateString(runner, resource.Body, "protocol")
+			if err != nil {
+				return nil, err
+			}
+			if protocol != "" {
+				protocols[fmt.Sprintf("%s.%s", resourceType, resource.Labels[1])] = strings.ToUpper(protocol)
+			}
+		}
+	}
+
+	return protocols, nil
+}
@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// loggingRuleConfig is the config of rules that require log types
+type loggingRuleConfig struct {
+	LogTypes []string `hclext:"log_types,optional"`
+}
+
+// decodeRequiredLogTypes returns the log types listed in the rule config, or the defaults if the config omits them
+func decodeRequiredLogTypes(runner tflint.Runner, rule tflint.Rule, defaults []string) ([]string, error) {
+	config := loggingRuleConfig{}
+	if err := runner.DecodeRuleConfig(rule.Name(), &config); err != nil {
+		return nil, err
+	}
+	if config.LogTypes == nil {
+		return defaults, nil
+	}
+	return config.LogTypes, nil
+}
+
+// checkLoggingBlock emits an issue if the block is not declared in the resource.
+// If enabledAttributeName is not empty, the attribute must also be set to true in the block.
+func checkLoggingBlock(runner tflint.Runner, rule tflint.Rule, resource *hclext.Block, blockName, enabledAttributeName string) error {
+	blocks := resource.Body.Blocks.OfType(blockName)
+	if len(blocks) == 0 {
+		return runner.EmitIssue(rule, fmt.Sprintf("`%s` block should be declared to enable logging", blockName), resource.DefRange)
+	}
+	if enabledAttributeName == "" {
+		return nil
+	}
+
+	for _, block := range blocks {
+		attribute, exists := block.Body.Attributes[enabledAttributeName]
+		if !exists {
+			if err := runner.EmitIssue(rule, fmt.Sprintf("`%s` should be set to true in `%s` block", enabledAttributeName, blockName), block.DefRange); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(enabled bool) error {
+			if enabled {
+				return nil
+			}
+			return runner.EmitIssue(rule, fmt.Sprintf("`%s` should be set to true in `%s` block", enabledAttributeName, blockName), attribute.Expr.Range())
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkLogTypesAttribute emits an issue if the list attribute does not include all required log types.
+// If no log types are required, the attribute must include at least one log type.
+func checkLogTypesAttribute(runner tflint.Runner, rule tflint.Rule, resource *hclext.Block, attributeName string, required []string) error {
+	attribute, exists := resource.Body.Attributes[attributeName]
+	if !exists {
+		return emitMissingLogTypes(runner, rule, attributeName, []string{}, required, resource.DefRange)
+	}
+
+	return runner.EvaluateExpr(attribute.Expr, func(enabled []string) error {
+		return emitMissingLogTypes(runner, rule, attributeName, enabled, required, attribute.Expr.Range())
+	}, nil)
+}
+
+// emitMissingLogTypes emits an issue listing the required log types that are not enabled
+func emitMissingLogTypes(runner tflint.Runner, rule tflint.Rule, attributeName string, enabled, required []string, rng hcl.Range) error {
+	if len(required) == 0 {
+		if len(enabled) > 0 {
+			return nil
+		}
+		return runner.EmitIssue(rule, fmt.Sprintf("`%s` should enable at least one log type", attributeName), rng)
+	}
+
+	missing := []string{}
+	for _, logType := range required {
+		if !stringInSlice(logType, enabled) {
+			missing = append(missing, fmt.Sprintf(`"%s"`, logType))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return runner.EmitIssue(rule, fmt.Sprintf("`%s` should enable %s", attributeName, strings.Join(missing, ", ")), rng)
+}
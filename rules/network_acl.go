@@ -0,0 +1,196 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// networkACLProtocols maps protocol names accepted by the AWS provider to IANA protocol numbers
+var networkACLProtocols = map[string]int{
+	"all": -1, "hopopt": 0, "icmp": 1, "igmp": 2, "ggp": 3, "ipv4": 4, "st": 5, "tcp": 6, "cbt": 7, "egp": 8,
+	"igp": 9, "bbn-rcc-mon": 10, "nvp-ii": 11, "pup": 12, "argus": 13, "emcon": 14, "xnet": 15, "chaos": 16,
+	"udp": 17, "mux": 18, "dcn-meas": 19, "hmp": 20, "prm": 21, "xns-idp": 22, "trunk-1": 23, "trunk-2": 24,
+	"leaf-1": 25, "leaf-2": 26, "rdp": 27, "irtp": 28, "iso-tp4": 29, "netblt": 30, "mfe-nsp": 31,
+	"merit-inp": 32, "dccp": 33, "3pc": 34, "idpr": 35, "xtp": 36, "ddp": 37, "idpr-cmtp": 38, "tp++": 39,
+	"il": 40, "ipv6": 41, "sdrp": 42, "ipv6-route": 43, "ipv6-frag": 44, "idrp": 45, "rsvp": 46, "gre": 47,
+	"dsr": 48, "bna": 49, "esp": 50, "ah": 51, "i-nlsp": 52, "swipe": 53, "narp": 54, "mobile": 55, "tlsp": 56,
+	"ipv6-icmp": 58, "ipv6-nonxt": 59, "ipv6-opts": 60, "cftp": 62, "sat-expak": 64, "kryptolan": 65, "rvd": 66,
+	"ippc": 67, "sat-mon": 69, "visa": 70, "ipcv": 71, "cpnx": 72, "cphb": 73, "wsn": 74, "pvp": 75,
+	"br-sat-mon": 76, "sun-nd": 77, "wb-mon": 78, "wb-expak": 79, "iso-ip": 80, "vmtp": 81, "secure-vmtp": 82,
+	"vines": 83, "ttp": 84, "nsfnet-igp": 85, "dgp": 86, "tcf": 87, "eigrp": 88, "ospfigp": 89,
+	"sprite-rpc": 90, "larp": 91, "mtp": 92, "ax.25": 93, "ipip": 94, "micp": 95, "scc-sp": 96, "etherip": 97,
+	"encap": 98, "gmtp": 100, "ifmp": 101, "pnni": 102, "pim": 103, "aris": 104, "scps": 105, "qnx": 106,
+	"a/n": 107, "ipcomp": 108, "snp": 109, "compaq-peer": 110, "ipx-in-ip": 111, "vrrp": 112, "pgm": 113,
+	"l2tp": 115, "dd": 116, "iatp": 117, "stp": 118, "srp": 119, "uti": 120, "smp": 121, "sm": 122, "ptp": 123,
+	"isis-over-ipv4": 124, "fire": 125, "crtp": 126, "crudp": 127, "sscopmce": 128, "iplt": 129, "sps": 130,
+	"pipe": 131, "sctp": 132, "fc": 133, "rsvp-e2e-ignore": 134, "mobility-header": 135, "udplite": 136,
+	"mpls-in-ip": 137, "manet": 138, "hip": 139, "shim6": 140, "wesp": 141, "rohc": 142,
+	// Accepted by aws_security_group_invalid_protocol
+	"icmpv6": 58,
+}
+
+// networkACLProtocolNumber returns the IANA protocol number of the protocol, and whether it is valid.
+// Like aws_security_group_invalid_protocol, any integer is accepted as a string and names are case-insensitive.
+func networkACLProtocolNumber(protocol string) (int, bool) {
+	if number, err := strconv.Atoi(protocol); err == nil {
+		return number, true
+	}
+	number, ok := networkACLProtocols[strings.ToLower(protocol)]
+	return number, ok
+}
+
+// networkACLEntry is a rule declared by aws_network_acl_rule, or an ingress/egress block of aws_network_acl or aws_default_network_acl
+type networkACLEntry struct {
+	// resource is the address of the resource that declares the entry
+	resource string
+	// acl identifies the network ACL by a resource address or a literal network ACL ID.
+	// It is empty if the network ACL cannot be determined.
+	acl string
+	// egress is nil if the direction cannot be determined
+	egress   *bool
+	inline   bool
+	body     *hclext.BodyContent
+	defRange hcl.Range
+	// ruleNumberAttribute and actionAttribute are "rule_no" and "action" in inline blocks
+	ruleNumberAttribute string
+	actionAttribute     string
+}
+
+// direction returns "ingress" or "egress", or an empty string if the direction cannot be determined
+func (e *networkACLEntry) direction() string {
+	if e.egress == nil {
+		return ""
+	}
+	if *e.egress {
+		return "egress"
+	}
+	return "ingress"
+}
+
+// String returns a description of the entry for messages
+func (e *networkACLEntry) String() string {
+	if e.inline {
+		return fmt.Sprintf("an %s block of %s", e.direction(), e.resource)
+	}
+	return e.resource
+}
+
+var networkACLEntryAttributes = []string{"protocol", "cidr_block", "ipv6_cidr_block", "from_port", "to_port", "icmp_type", "icmp_code"}
+
+func networkACLEntrySchema(attributes ...string) *hclext.BodySchema {
+	schema := &hclext.BodySchema{}
+	for _, name := range append(attributes, networkACLEntryAttributes...) {
+		schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: name})
+	}
+	return schema
+}
+
+// walkNetworkACLEntries visits aws_network_acl_rule resources and ingress/egress blocks of aws_network_acl and aws_default_network_acl
+func walkNetworkACLEntries(runner tflint.Runner, walker func(*networkACLEntry) error) error {
+	resources, err := runner.GetResourceContent("aws_network_acl_rule", networkACLEntrySchema("network_acl_id", "egress", "rule_number", "rule_action"), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		acl := ""
+		if attribute, exists := resource.Body.Attributes["network_acl_id"]; exists {
+			var ok bool
+			if acl, ok = resourceReference(attribute.Expr, "aws_network_acl", "id"); !ok {
+				if acl, ok = resourceReference(attribute.Expr, "aws_default_network_acl", "id"); !ok {
+					if err := runner.EvaluateExpr(attribute.Expr, func(id string) error {
+						acl = id
+						return nil
+					}, nil); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		// egress defaults to false
+		egress := new(bool)
+		if attribute, exists := resource.Body.Attributes["egress"]; exists {
+			if err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
+				switch {
+				case !val.IsKnown():
+					egress = nil
+				case !val.IsNull():
+					*egress = val.True()
+				}
+				return nil
+			}, &tflint.EvaluateExprOption{WantType: &cty.Bool}); err != nil {
+				return err
+			}
+		}
+
+		if err := walker(&networkACLEntry{
+			resource:            fmt.Sprintf("aws_network_acl_rule.%s", resource.Labels[1]),
+			acl:                 acl,
+			egress:              egress,
+			body:                resource.Body,
+			defRange:            resource.DefRange,
+			ruleNumberAttribute: "rule_number",
+			actionAttribute:     "rule_action",
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, resourceType := range []string{"aws_network_acl", "aws_default_network_acl"} {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Blocks: []hclext.BlockSchema{
+				{Type: "ingress", Body: networkACLEntrySchema("rule_no", "action")},
+				{Type: "egress", Body: networkACLEntrySchema("rule_no", "action")},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			address := fmt.Sprintf("%s.%s", resourceType, resource.Labels[1])
+
+			for _, block := range resource.Body.Blocks {
+				egress := block.Type == "egress"
+
+				if err := walker(&networkACLEntry{
+					resource:            address,
+					acl:                 address,
+					egress:              &egress,
+					inline:              true,
+					body:                block.Body,
+					defRange:            block.DefRange,
+					ruleNumberAttribute: "rule_no",
+					actionAttribute:     "action",
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// networkACLEntryProtocol returns the protocol of the entry and its IANA protocol number.
+// ok is false if the protocol is not declared, cannot be evaluated or is invalid.
+func networkACLEntryProtocol(runner tflint.Runner, entry *networkACLEntry) (protocol string, number int, ok bool, err error) {
+	attribute, exists := entry.body.Attributes["protocol"]
+	if !exists {
+		return "", 0, false, nil
+	}
+
+	err = runner.EvaluateExpr(attribute.Expr, func(val string) error {
+		protocol = val
+		number, ok = networkACLProtocolNumber(val)
+		return nil
+	}, nil)
+	return protocol, number, ok, err
+}
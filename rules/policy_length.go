@@ -0,0 +1,115 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// policyWhitespaceRegex matches whitespace that AWS does not count towards policy size quotas
+var policyWhitespaceRegex = regexp.MustCompile(`\s+`)
+
+// policyLengthLimit is the maximum length of policies declared in a resource attribute
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length
+type policyLengthLimit struct {
+	resourceType  string
+	attributeName string
+	limit         int
+
+	// policyTypes limits the check to resources whose `type` attribute is one of the values.
+	// If the attribute is omitted, the resource is treated as defaultPolicyType.
+	policyTypes       []string
+	defaultPolicyType string
+}
+
+// policyLength returns the length of the policy ignoring whitespace
+func policyLength(policy string) int {
+	return len(policyWhitespaceRegex.ReplaceAllString(policy, ""))
+}
+
+// policyLengthChecker checks policy lengths against limits.
+// References to `aws_iam_policy_document` data sources are measured by rendering the data source.
+type policyLengthChecker struct {
+	runner    tflint.Runner
+	documents map[string]string
+}
+
+// newPolicyLengthChecker returns a checker with rendered `aws_iam_policy_document` data sources
+func newPolicyLengthChecker(runner tflint.Runner) (*policyLengthChecker, error) {
+	documents, err := renderIAMPolicyDocumentDataSources(runner)
+	if err != nil {
+		return nil, err
+	}
+	return &policyLengthChecker{runner: runner, documents: documents}, nil
+}
+
+// check emits an issue for every policy that exceeds the limit
+func (c *policyLengthChecker) check(rule tflint.Rule, limit policyLengthLimit) error {
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: limit.attributeName}},
+	}
+	if len(limit.policyTypes) > 0 {
+		schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: "type"})
+	}
+
+	resources, err := c.runner.GetResourceContent(limit.resourceType, schema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[limit.attributeName]
+		if !exists {
+			continue
+		}
+
+		if len(limit.policyTypes) > 0 {
+			policyType := limit.defaultPolicyType
+			if attr, exists := resource.Body.Attributes["type"]; exists {
+				policyType = ""
+				if err := c.runner.EvaluateExpr(attr.Expr, func(val string) error {
+					policyType = val
+					return nil
+				}, nil); err != nil {
+					return err
+				}
+			}
+			if !stringInSlice(policyType, limit.policyTypes) {
+				continue
+			}
+		}
+
+		err := c.evaluate(attribute, func(length int) error {
+			if length > limit.limit {
+				return c.runner.EmitIssue(
+					rule,
+					fmt.Sprintf("The policy length is %d characters and is limited to %d characters.", length, limit.limit),
+					attribute.Expr.Range(),
+				)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// evaluate calls the callback with the length of the policy if it is known
+func (c *policyLengthChecker) evaluate(attribute *hclext.Attribute, callback func(int) error) error {
+	if name, ok := iamPolicyDocumentDataSourceReference(attribute.Expr); ok {
+		document, exists := c.documents[name]
+		if !exists {
+			return nil
+		}
+		return callback(policyLength(document))
+	}
+
+	return c.runner.EvaluateExpr(attribute.Expr, func(policy string) error {
+		return callback(policyLength(policy))
+	}, nil)
+}
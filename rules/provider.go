@@ -11,7 +11,10 @@ var manualRules = []tflint.Rule{
 	NewAwsDBInstanceInvalidEngineRule(),
 	NewAwsDBInstanceInvalidTypeRule(),
 	NewAwsDBInstancePreviousTypeRule(),
+	NewAwsDBInstancePubliclyAccessibleRule(),
 	NewAwsDynamoDBTableInvalidStreamViewTypeRule(),
+	NewAwsECSTaskDefinitionInvalidContainerDefinitionsRule(),
+	NewAwsECSTaskDefinitionInsecureContainerDefinitionsRule(),
 	NewAwsElastiCacheClusterDefaultParameterGroupRule(),
 	NewAwsElastiCacheClusterInvalidTypeRule(),
 	NewAwsElastiCacheClusterPreviousTypeRule(),
@@ -19,15 +22,38 @@ var manualRules = []tflint.Rule{
 	NewAwsIAMPolicyGovFriendlyArnsRule(),
 	NewAwsIAMRolePolicyGovFriendlyArnsRule(),
 	NewAwsInstancePreviousTypeRule(),
+	NewAwsInstanceIMDSv2RequiredRule(),
+	NewAwsLbListenerMissingCertificateRule(),
+	NewAwsLbListenerProtocolMismatchRule(),
+	NewAwsLbListenerRuleDuplicatePriorityRule(),
+	NewAwsLbTargetGroupInvalidHealthCheckMatcherRule(),
+	NewAwsLbTargetGroupInvalidLambdaAttributesRule(),
 	NewAwsMqBrokerInvalidEngineTypeRule(),
 	NewAwsMqConfigurationInvalidEngineTypeRule(),
+	NewAwsNetworkACLDuplicateRuleNumberRule(),
+	NewAwsNetworkACLInvalidICMPRule(),
+	NewAwsNetworkACLInvalidProtocolRule(),
+	NewAwsNetworkACLInvalidRuleNumberRule(),
+	NewAwsNetworkACLUnrestrictedAdminPortsRule(),
+	NewAwsResourceInvalidARNRegionRule(),
+	NewAwsResourceInvalidAvailabilityZoneRule(),
+	NewAwsResourceInvalidTagsRule(),
 	NewAwsResourceMissingTagsRule(),
+	NewAwsResourceTagKeyConventionRule(),
+	NewAwsRouteDestinationIPVersionMismatchRule(),
+	NewAwsRouteDuplicateDestinationRule(),
 	NewAwsRouteNotSpecifiedTargetRule(),
 	NewAwsRouteSpecifiedMultipleTargetsRule(),
+	NewAwsRoute53RecordCnameAtZoneApexRule(),
+	NewAwsRoute53RecordDuplicateRule(),
+	NewAwsRoute53RecordInvalidAliasRule(),
+	NewAwsRoute53RecordInvalidRecordsRule(),
+	NewAwsRoute53RecordInvalidRoutingPolicyRule(),
 	NewAwsS3BucketInvalidACLRule(),
 	NewAwsS3BucketNameRule(),
 	NewAwsSpotFleetRequestInvalidExcessCapacityTerminationPolicyRule(),
 	NewAwsAPIGatewayModelInvalidNameRule(),
+	NewAwsAPIGatewayRestAPIInvalidBodyRule(),
 	NewAwsElastiCacheReplicationGroupDefaultParameterGroupRule(),
 	NewAwsElastiCacheReplicationGroupInvalidTypeRule(),
 	NewAwsElastiCacheReplicationGroupPreviousTypeRule(),
@@ -39,6 +65,40 @@ var manualRules = []tflint.Rule{
 	NewAwsElasticBeanstalkEnvironmentInvalidNameFormatRule(),
 	NewAwsSecurityGroupInvalidProtocolRule(),
 	NewAwsSecurityGroupRuleInvalidProtocolRule(),
+	NewAwsIAMPolicyInvalidEffectRule(),
+	NewAwsIAMPolicyInvalidConditionOperatorRule(),
+	NewAwsIAMPolicyAdminAccessRule(),
+	NewAwsIAMPolicyAllowNotActionRule(),
+	NewAwsIAMPolicyWildcardPassRoleRule(),
+	NewAwsIAMPolicyInvalidActionRule(),
+	NewAwsIAMRoleInsecureTrustPolicyRule(),
+	NewAwsResourcePolicyPublicAccessRule(),
+	NewAwsResourceHardcodedSecretsRule(),
+	NewAwsPolicyTooLongRule(),
+	NewAwsCloudtrailBaselineRule(),
+	NewAwsConfigConfigurationRecorderAllResourcesRule(),
+	NewAwsGuarddutyDetectorEnabledRule(),
+	NewAwsAPIGatewayStageLoggingEnabledRule(),
+	NewAwsApigatewayv2StageLoggingEnabledRule(),
+	NewAwsCloudfrontDistributionInvalidCertificateRegionRule(),
+	NewAwsCloudfrontDistributionLoggingEnabledRule(),
+	NewAwsEksClusterLoggingEnabledRule(),
+	NewAwsLbLoggingEnabledRule(),
+	NewAwsOpensearchDomainLoggingEnabledRule(),
+	NewAwsRdsClusterLoggingEnabledRule(),
+	NewAwsS3BucketLoggingEnabledRule(),
+	NewAwsVpcEndpointInvalidServiceNameRule(),
+	NewAwsVpcEndpointTypeMismatchRule(),
+	NewAwsVpcInvalidCidrBlockRule(),
+	NewAwsWafv2InvalidScopeRegionRule(),
+	NewAwsSfnStateMachineInvalidStructureRule(),
+	NewAwsCloudwatchEventRuleInvalidEventPatternRule(),
+	NewAwsCloudwatchEventTargetInvalidInputTransformerRule(),
+	NewAwsCloudwatchMetricAlarmInvalidActionRegionRule(),
+	NewAwsCloudwatchMetricAlarmInvalidMetricQueryRule(),
+	NewAwsCloudwatchMetricAlarmInvalidPeriodRule(),
+	NewAwsCloudwatchMetricAlarmInvalidStatisticRule(),
+	NewAwsCloudwatchMetricAlarmInvalidThresholdRule(),
 }
 
 // Rules is a list of all rules
@@ -0,0 +1,258 @@
+package rules
+
+import (
+	"sort"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-aws/aws"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	defaultTagsBlockName  = "default_tags"
+	tagsAttributeName     = "tags"
+	tagBlockName          = "tag"
+	providerAttributeName = "provider"
+)
+
+// tagSet is a set of tags evaluated from the configuration
+type tagSet struct {
+	// values maps tag keys to values. Values that cannot be evaluated are unknown values.
+	values map[string]cty.Value
+	// unknown is true if some keys cannot be determined, such as tags from module outputs
+	unknown bool
+}
+
+func newTagSet() *tagSet {
+	return &tagSet{values: map[string]cty.Value{}}
+}
+
+// tagSetFromValue returns the tags of a map or object value.
+// The set is unknown if the value is unknown or is not a map.
+func tagSetFromValue(val cty.Value) *tagSet {
+	tags := newTagSet()
+	val, _ = val.UnmarkDeep()
+
+	switch {
+	case !val.IsKnown():
+		tags.unknown = true
+	case val.IsNull():
+		// No tags
+	case val.Type().IsMapType() || val.Type().IsObjectType():
+		for key, value := range val.AsValueMap() {
+			tags.values[key] = value
+		}
+	default:
+		tags.unknown = true
+	}
+	return tags
+}
+
+// merge overrides the tags with the passed tags. The set becomes unknown if the passed set is unknown.
+func (s *tagSet) merge(other *tagSet) {
+	if other == nil {
+		return
+	}
+	s.unknown = s.unknown || other.unknown
+	maps.Copy(s.values, other.values)
+}
+
+// has returns whether the key is declared
+func (s *tagSet) has(key string) bool {
+	_, exists := s.values[key]
+	return exists
+}
+
+// value returns the value of the tag as a string, and whether the value is known.
+// Null values are empty strings.
+func (s *tagSet) value(key string) (string, bool) {
+	val, exists := s.values[key]
+	if !exists || !val.IsKnown() {
+		return "", false
+	}
+	if val.IsNull() {
+		return "", true
+	}
+
+	val, err := convert.Convert(val, cty.String)
+	if err != nil || !val.IsKnown() || val.IsNull() {
+		return "", false
+	}
+	return val.AsString(), true
+}
+
+// keys returns the sorted keys of the tags
+func (s *tagSet) keys() []string {
+	keys := maps.Keys(s.values)
+	sort.Strings(keys)
+	return keys
+}
+
+// isEmpty returns whether no tags are declared
+func (s *tagSet) isEmpty() bool {
+	return len(s.values) == 0 && !s.unknown
+}
+
+// evaluateTags evaluates a tags attribute
+func evaluateTags(runner tflint.Runner, expr hcl.Expression) (*tagSet, error) {
+	var tags *tagSet
+	err := runner.EvaluateExpr(expr, func(val cty.Value) error {
+		tags = tagSetFromValue(val)
+		return nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The callback is not called if the expression cannot be evaluated by TFLint
+	if tags == nil {
+		tags = &tagSet{values: map[string]cty.Value{}, unknown: true}
+	}
+	return tags, nil
+}
+
+// getProviderLevelTags returns the default tags of aws providers keyed by alias.
+// The provider without an alias is keyed by "default".
+func getProviderLevelTags(runner tflint.Runner) (map[string]*tagSet, error) {
+	providerSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{
+				Name:     "alias",
+				Required: false,
+			},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: defaultTagsBlockName,
+				Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: tagsAttributeName}}},
+			},
+		},
+	}
+
+	providerBody, err := runner.GetProviderContent("aws", providerSchema, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get provider default tags
+	allProviderTags := make(map[string]*tagSet)
+	var providerAlias string
+	for _, provider := range providerBody.Blocks.OfType(providerAttributeName) {
+		// Get the alias attribute, in terraform when there is a single aws provider its called "default"
+		providerAttr, ok := provider.Body.Attributes["alias"]
+		if !ok {
+			providerAlias = "default"
+		} else {
+			err := runner.EvaluateExpr(providerAttr.Expr, func(alias string) error {
+				logger.Debug("Walk `%s` provider", providerAlias)
+				providerAlias = alias
+				// Init the provider reference even if it doesn't have tags
+				allProviderTags[alias] = nil
+				return nil
+			}, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, block := range provider.Body.Blocks {
+			attr, ok := block.Body.Attributes[tagsAttributeName]
+			if !ok {
+				continue
+			}
+
+			providerTags, err := evaluateTags(runner, attr.Expr)
+			if err != nil {
+				return nil, err
+			}
+			logger.Debug("Walk `%s` provider with tags `%v`", providerAlias, providerTags.keys())
+
+			allProviderTags[providerAlias] = providerTags
+		}
+	}
+	return allProviderTags, nil
+}
+
+// resourceProviderTags returns the default tags of the provider that the resource uses.
+// The tags are unknown if the provider is not declared in the module, such as a provider passed by the caller.
+// The "provider" attribute must be included in the schema of the resource.
+func resourceProviderTags(resource *hclext.Block, providerTagsMap map[string]*tagSet) (*tagSet, error) {
+	providerAlias := "default"
+	// Override the provider alias if defined
+	if val, ok := resource.Body.Attributes[providerAttributeName]; ok {
+		provider, diagnostics := aws.DecodeProviderConfigRef(val.Expr, "provider")
+		if diagnostics.HasErrors() {
+			logger.Error("error decoding provider: %w", diagnostics)
+			return nil, diagnostics
+		}
+
+		// `provider = aws` refers to the default provider
+		if provider.Alias != "" {
+			providerAlias = provider.Alias
+
+			if _, hasProvider := providerTagsMap[providerAlias]; !hasProvider {
+				logger.Debug("The aws provider with alias \"%s\" is not declared in the module, so its tags are unknown", providerAlias)
+				return &tagSet{values: map[string]cty.Value{}, unknown: true}, nil
+			}
+		}
+	}
+
+	return providerTagsMap[providerAlias], nil
+}
+
+// walkResourceTags visits resources of the type with their tags merged into the default tags of the provider,
+// like the tags_all attribute of the AWS provider.
+// The location is the range of the tags attribute, or the resource DefRange if the attribute is not declared.
+func walkResourceTags(runner tflint.Runner, resourceType string, providerTagsMap map[string]*tagSet, walker func(tags *tagSet, location hcl.Range) error) error {
+	resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: tagsAttributeName},
+			{Name: providerAttributeName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		providerTags, err := resourceProviderTags(resource, providerTagsMap)
+		if err != nil {
+			return err
+		}
+
+		// The provider tags are to be overriden
+		// https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags
+		resourceTags := newTagSet()
+		resourceTags.merge(providerTags)
+
+		// If the resource has a tags attribute
+		if attribute, okResource := resource.Body.Attributes[tagsAttributeName]; okResource {
+			logger.Debug(
+				"Walk `%s` attribute",
+				resource.Labels[0]+"."+resource.Labels[1]+"."+tagsAttributeName,
+			)
+
+			evaluatedTags, err := evaluateTags(runner, attribute.Expr)
+			if err != nil {
+				return err
+			}
+			resourceTags.merge(evaluatedTags)
+
+			if err := walker(resourceTags, attribute.Expr.Range()); err != nil {
+				return err
+			}
+		} else {
+			logger.Debug("Walk `%s` resource", resource.Labels[0]+"."+resource.Labels[1])
+			if err := walker(resourceTags, resource.DefRange); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
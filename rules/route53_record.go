@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// route53RoutingPolicyBlocks are blocks of aws_route53_record that declare routing policies
+var route53RoutingPolicyBlocks = []string{
+	"cidr_routing_policy",
+	"failover_routing_policy",
+	"geolocation_routing_policy",
+	"geoproximity_routing_policy",
+	"latency_routing_policy",
+	"weighted_routing_policy",
+}
+
+// route53RecordSchema is a schema of aws_route53_record shared by the record rules
+var route53RecordSchema = func() *hclext.BodySchema {
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "zone_id"},
+			{Name: "name"},
+			{Name: "type"},
+			{Name: "ttl"},
+			{Name: "records"},
+			{Name: "set_identifier"},
+			{Name: "multivalue_answer_routing_policy"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{Type: "alias", Body: &hclext.BodySchema{}},
+		},
+	}
+	for _, name := range route53RoutingPolicyBlocks {
+		schema.Blocks = append(schema.Blocks, hclext.BlockSchema{Type: name, Body: &hclext.BodySchema{}})
+	}
+	return schema
+}()
+
+// route53Zones resolves zone_id of records to hosted zones declared in the module
+type route53Zones struct {
+	// names maps addresses of aws_route53_zone to the domain names
+	names map[string]string
+}
+
+func newRoute53Zones(runner tflint.Runner) (*route53Zones, error) {
+	resources, err := runner.GetResourceContent("aws_route53_zone", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "name"}},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := &route53Zones{names: map[string]string{}}
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes["name"]
+		if !exists {
+			continue
+		}
+
+		address := fmt.Sprintf("aws_route53_zone.%s", resource.Labels[1])
+		if err := runner.EvaluateExpr(attribute.Expr, func(name string) error {
+			zones.names[address] = normalizeRoute53Name(name)
+			return nil
+		}, nil); err != nil {
+			return nil, err
+		}
+	}
+	return zones, nil
+}
+
+// zone returns a key that identifies the hosted zone of the record, and the domain name of the zone if declared in the module.
+// The key is the address of aws_route53_zone, or the literal zone ID.
+func (z *route53Zones) zone(runner tflint.Runner, record *hclext.Block) (string, string, error) {
+	attribute, exists := record.Body.Attributes["zone_id"]
+	if !exists {
+		return "", "", nil
+	}
+
+	if address, ok := resourceReference(attribute.Expr, "aws_route53_zone", "zone_id", "id"); ok {
+		return address, z.names[address], nil
+	}
+
+	zoneID := ""
+	err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+		zoneID = val
+		return nil
+	}, nil)
+	return zoneID, "", err
+}
+
+// recordName returns the fully qualified name of the record.
+// Like the AWS provider, the zone name is appended to names that are not in the zone.
+func (z *route53Zones) recordName(name, zoneName string) string {
+	name = normalizeRoute53Name(name)
+	if zoneName == "" || name == zoneName || strings.HasSuffix(name, "."+zoneName) {
+		return name
+	}
+	if name == "" {
+		return zoneName
+	}
+	return name + "." + zoneName
+}
+
+// normalizeRoute53Name lowercases the domain name and removes the trailing dot
+func normalizeRoute53Name(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
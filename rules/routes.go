@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"fmt"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// routeTargetAttributes are attributes of routes that specify routing targets
+var routeTargetAttributes = []string{
+	"gateway_id",
+	"egress_only_gateway_id",
+	"nat_gateway_id",
+	"instance_id",
+	"vpc_peering_connection_id",
+	"network_interface_id",
+	"transit_gateway_id",
+	"vpc_endpoint_id",
+	"carrier_gateway_id",
+	"local_gateway_id",
+}
+
+// routeCoreNetworkAttribute specifies a Cloud WAN core network as the target.
+// It satisfies the requirement of a target, but is not counted as one of multiple targets.
+const routeCoreNetworkAttribute = "core_network_arn"
+
+// routeDestinationAttributes are names of destination attributes in aws_route and in inline route blocks
+type routeDestinationAttributes struct {
+	ipv4       string
+	ipv6       string
+	prefixList string
+}
+
+var (
+	standaloneRouteDestinations = routeDestinationAttributes{
+		ipv4:       "destination_cidr_block",
+		ipv6:       "destination_ipv6_cidr_block",
+		prefixList: "destination_prefix_list_id",
+	}
+	inlineRouteDestinations = routeDestinationAttributes{
+		ipv4:       "cidr_block",
+		ipv6:       "ipv6_cidr_block",
+		prefixList: "destination_prefix_list_id",
+	}
+)
+
+// tableRoute is a route declared by aws_route, or a route block of aws_route_table or aws_default_route_table
+type tableRoute struct {
+	resourceType string
+	// resource is the address of the resource that declares the route
+	resource string
+	// table identifies the route table by a resource address or a literal route table ID.
+	// It is empty if the route table cannot be determined.
+	table        string
+	inline       bool
+	body         *hclext.BodyContent
+	defRange     hcl.Range
+	destinations routeDestinationAttributes
+}
+
+// kind returns the kind of the route, such as "aws_route" and "route block of aws_route_table"
+func (r *tableRoute) kind() string {
+	if r.inline {
+		return fmt.Sprintf("route block of %s", r.resourceType)
+	}
+	return r.resourceType
+}
+
+// String returns a description of the route for messages
+func (r *tableRoute) String() string {
+	if r.inline {
+		return fmt.Sprintf("a route block of %s", r.resource)
+	}
+	return r.resource
+}
+
+func routeBodySchema(destinations routeDestinationAttributes, extra ...string) *hclext.BodySchema {
+	schema := &hclext.BodySchema{}
+	for _, name := range append(append([]string{destinations.ipv4, destinations.ipv6, destinations.prefixList, routeCoreNetworkAttribute}, extra...), routeTargetAttributes...) {
+		schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: name})
+	}
+	return schema
+}
+
+// walkRoutes visits aws_route resources and route blocks of aws_route_table and aws_default_route_table
+func walkRoutes(runner tflint.Runner, walker func(*tableRoute) error) error {
+	resources, err := runner.GetResourceContent("aws_route", routeBodySchema(standaloneRouteDestinations, "route_table_id"), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		table := ""
+		if attribute, exists := resource.Body.Attributes["route_table_id"]; exists {
+			var ok bool
+			if table, ok = resourceReference(attribute.Expr, "aws_route_table", "id"); !ok {
+				if table, ok = resourceReference(attribute.Expr, "aws_default_route_table", "id"); !ok {
+					if err := runner.EvaluateExpr(attribute.Expr, func(id string) error {
+						table = id
+						return nil
+					}, nil); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if err := walker(&tableRoute{
+			resourceType: "aws_route",
+			resource:     fmt.Sprintf("aws_route.%s", resource.Labels[1]),
+			table:        table,
+			body:         resource.Body,
+			defRange:     resource.DefRange,
+			destinations: standaloneRouteDestinations,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, resourceType := range []string{"aws_route_table", "aws_default_route_table"} {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Blocks: []hclext.BlockSchema{
+				{Type: "route", Body: routeBodySchema(inlineRouteDestinations)},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			address := fmt.Sprintf("%s.%s", resourceType, resource.Labels[1])
+
+			for _, block := range resource.Body.Blocks {
+				if err := walker(&tableRoute{
+					resourceType: resourceType,
+					resource:     address,
+					table:        address,
+					inline:       true,
+					body:         block.Body,
+					defRange:     block.DefRange,
+					destinations: inlineRouteDestinations,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// countRouteTargets returns the number of target attributes that are not null.
+// Extra attributes are counted in addition to routeTargetAttributes.
+func countRouteTargets(runner tflint.Runner, route *tableRoute, extra ...string) (int, error) {
+	count := 0
+	for _, name := range append(append([]string{}, routeTargetAttributes...), extra...) {
+		attribute, exists := route.body.Attributes[name]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(val cty.Value) error {
+			if !val.IsNull() {
+				count++
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
@@ -1,5 +1,72 @@
 package rules
 
+import (
+	"fmt"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// resourceReference returns the resource address if the expression refers to one of the passed attributes
+// of the resource type, such as `aws_s3_bucket.example.id`
+func resourceReference(expr hcl.Expression, resourceType string, attributeNames ...string) (string, bool) {
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() || len(traversal) != 3 || traversal.RootName() != resourceType {
+		return "", false
+	}
+
+	name, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	attribute, ok := traversal[2].(hcl.TraverseAttr)
+	if !ok || !stringInSlice(attribute.Name, attributeNames) {
+		return "", false
+	}
+	return fmt.Sprintf("%s.%s", resourceType, name.Name), true
+}
+
+// knownStrings returns known strings in a string, or in a list, set or tuple of strings.
+// Unknown, null and sensitive elements are ignored.
+func knownStrings(val cty.Value) []string {
+	if !val.IsKnown() || val.IsNull() || val.IsMarked() {
+		return []string{}
+	}
+
+	if val.Type() == cty.String {
+		return []string{val.AsString()}
+	}
+
+	ret := []string{}
+	if val.CanIterateElements() && !val.Type().IsMapType() && !val.Type().IsObjectType() {
+		for it := val.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			if element.IsKnown() && !element.IsNull() && !element.IsMarked() && element.Type() == cty.String {
+				ret = append(ret, element.AsString())
+			}
+		}
+	}
+	return ret
+}
+
+func stringInSlice(a string, list []string) bool {
+	for _, b := range list {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
+func intInSlice(a int, list []int) bool {
+	for _, b := range list {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
 var validElastiCacheNodeTypes = map[string]bool{
 	// https://docs.aws.amazon.com/AmazonElastiCache/latest/red-ug/CacheNodes.SupportedTypes.html
 	"cache.t2.micro":      true,
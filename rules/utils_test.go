@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/terraform/lang/marks"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// unknownModuleRunner is a test runner that evaluates references to module outputs and variables without defaults
+// as unknown values like TFLint. The runner of the SDK helper cannot evaluate module outputs and evaluates such variables as null.
+// It also marks values that refer to sensitive variables, which the runner of the SDK helper does not.
+type unknownModuleRunner struct {
+	*helper.Runner
+
+	// unknownVariables are the names of variables without defaults
+	unknownVariables map[string]bool
+	// sensitiveVariables are the names of variables declared with sensitive = true
+	sensitiveVariables map[string]bool
+}
+
+func newUnknownModuleRunner(t *testing.T, files map[string]string) *unknownModuleRunner {
+	runner := helper.TestRunner(t, files)
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "default"}, {Name: "sensitive"}}},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unknownVariables := map[string]bool{}
+	sensitiveVariables := map[string]bool{}
+	for _, variable := range content.Blocks {
+		if _, exists := variable.Body.Attributes["default"]; !exists {
+			unknownVariables[variable.Labels[0]] = true
+		}
+		if attr, exists := variable.Body.Attributes["sensitive"]; exists {
+			if val, diags := attr.Expr.Value(nil); !diags.HasErrors() && val.True() {
+				sensitiveVariables[variable.Labels[0]] = true
+			}
+		}
+	}
+
+	return &unknownModuleRunner{Runner: runner, unknownVariables: unknownVariables, sensitiveVariables: sensitiveVariables}
+}
+
+// EvaluateExpr invokes callbacks that take cty.Value with an unknown value if the expression refers to modules
+// or variables without defaults, and with a sensitive value if it refers to sensitive variables.
+// Other callbacks are not invoked for such expressions.
+func (r *unknownModuleRunner) EvaluateExpr(expr hcl.Expression, target interface{}, opts *tflint.EvaluateExprOption) error {
+	callback := reflect.ValueOf(target)
+	valueCallback := callback.Kind() == reflect.Func && callback.Type().In(0) == reflect.TypeOf(cty.Value{})
+
+	for _, traversal := range expr.Variables() {
+		switch traversal.RootName() {
+		case "module":
+		case "var":
+			// Only references to the whole variable are unknown, so that keys of object expressions can be evaluated.
+			attr, ok := traversal[1].(hcl.TraverseAttr)
+			if _, diags := hcl.AbsTraversalForExpr(expr); diags.HasErrors() || !ok || !r.unknownVariables[attr.Name] {
+				continue
+			}
+		default:
+			continue
+		}
+
+		if valueCallback {
+			if err := callback.Call([]reflect.Value{reflect.ValueOf(cty.DynamicVal)})[0]; !err.IsNil() {
+				return err.Interface().(error)
+			}
+		}
+		return nil
+	}
+
+	for _, traversal := range expr.Variables() {
+		if attr, ok := traversal[1].(hcl.TraverseAttr); !ok || traversal.RootName() != "var" || !r.sensitiveVariables[attr.Name] {
+			continue
+		}
+
+		if !valueCallback {
+			return nil
+		}
+		return r.Runner.EvaluateExpr(expr, func(val cty.Value) error {
+			if err := callback.Call([]reflect.Value{reflect.ValueOf(val.Mark(marks.Sensitive))})[0]; !err.IsNil() {
+				return err.Interface().(error)
+			}
+			return nil
+		}, opts)
+	}
+
+	return r.Runner.EvaluateExpr(expr, target, opts)
+}